@@ -21,6 +21,8 @@ import (
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	evidencekeeper "github.com/cosmos/cosmos-sdk/x/evidence/keeper"
 	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feegrantkeeper "github.com/cosmos/cosmos-sdk/x/feegrant/keeper"
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/params"
@@ -50,6 +52,7 @@ import (
 	claimtypes "github.com/osmosis-labs/osmosis/x/claim/types"
 	epochskeeper "github.com/osmosis-labs/osmosis/x/epochs/keeper"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/x/gamm"
 	gammkeeper "github.com/osmosis-labs/osmosis/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 	incentiveskeeper "github.com/osmosis-labs/osmosis/x/incentives/keeper"
@@ -147,6 +150,13 @@ func (app *OsmosisApp) InitNormalKeepers(
 	)
 	app.AuthzKeeper = &authzKeeper
 
+	feeGrantKeeper := feegrantkeeper.NewKeeper(
+		appCodec,
+		keys[feegrant.StoreKey],
+		app.AccountKeeper,
+	)
+	app.FeeGrantKeeper = &feeGrantKeeper
+
 	stakingKeeper := stakingkeeper.NewKeeper(
 		appCodec,
 		keys[stakingtypes.StoreKey],
@@ -219,13 +229,14 @@ func (app *OsmosisApp) InitNormalKeepers(
 		app.BankKeeper, app.StakingKeeper, app.DistrKeeper)
 
 	gammKeeper := gammkeeper.NewKeeper(
-		appCodec, keys[gammtypes.StoreKey],
+		appCodec, keys[gammtypes.StoreKey], app.tkeys[gammtypes.TStoreKey],
 		app.GetSubspace(gammtypes.ModuleName),
 		app.AccountKeeper, app.BankKeeper, app.DistrKeeper)
 	app.GAMMKeeper = &gammKeeper
 
 	app.LockupKeeper = lockupkeeper.NewKeeper(
 		appCodec, keys[lockuptypes.StoreKey],
+		app.GetSubspace(lockuptypes.ModuleName),
 		// TODO: Visit why this needs to be deref'd
 		*app.AccountKeeper,
 		app.BankKeeper,
@@ -240,7 +251,7 @@ func (app *OsmosisApp) InitNormalKeepers(
 
 	app.SuperfluidKeeper = *superfluidkeeper.NewKeeper(
 		appCodec, keys[superfluidtypes.StoreKey], app.GetSubspace(superfluidtypes.ModuleName),
-		*app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.DistrKeeper, app.EpochsKeeper, app.LockupKeeper, gammKeeper, app.IncentivesKeeper)
+		*app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.DistrKeeper, app.EpochsKeeper, app.LockupKeeper, app.GAMMKeeper, app.IncentivesKeeper)
 
 	mintKeeper := mintkeeper.NewKeeper(
 		appCodec, keys[minttypes.StoreKey],
@@ -266,7 +277,12 @@ func (app *OsmosisApp) InitNormalKeepers(
 	txFeesKeeper := txfeeskeeper.NewKeeper(
 		appCodec,
 		keys[txfeestypes.StoreKey],
+		app.GetSubspace(txfeestypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.GAMMKeeper,
 		app.GAMMKeeper,
+		txfeestypes.NewMempoolFeeOptions(appOpts),
 	)
 	app.TxFeesKeeper = &txFeesKeeper
 
@@ -308,7 +324,7 @@ func (app *OsmosisApp) InitNormalKeepers(
 	// TODO: This appears to be missing tx fees proposal type
 	govRouter := govtypes.NewRouter()
 	govRouter.AddRoute(govtypes.RouterKey, govtypes.ProposalHandler).
-		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(*app.ParamsKeeper)).
+		AddRoute(paramproposal.RouterKey, gamm.NewParamChangeProposalHandler(*app.GAMMKeeper, params.NewParamChangeProposalHandler(*app.ParamsKeeper))).
 		AddRoute(distrtypes.RouterKey, distribution.NewCommunityPoolSpendProposalHandler(*app.DistrKeeper)).
 		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(*app.UpgradeKeeper)).
@@ -379,6 +395,7 @@ func (app *OsmosisApp) SetupHooks() {
 			app.SuperfluidKeeper.Hooks(),
 			app.IncentivesKeeper.Hooks(),
 			app.MintKeeper.Hooks(),
+			app.TxFeesKeeper.Hooks(),
 		),
 	)
 
@@ -32,7 +32,7 @@ func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator,
 		// }
 
 		// configure upgrade for gamm module's pool creation fee param add
-		gamm.SetParams(ctx, gammtypes.NewParams(sdk.Coins{sdk.NewInt64Coin("uosmo", 1)})) // 1 uOSMO
+		gamm.SetParams(ctx, gammtypes.NewParams(sdk.Coins{sdk.NewInt64Coin("uosmo", 1)}, gammtypes.MinPoolAssets, gammtypes.DefaultMaxPoolAssets)) // 1 uOSMO
 		// execute prop12. See implementation in
 		Prop12(ctx, bank, distr)
 		return vm, nil
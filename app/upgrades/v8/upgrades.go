@@ -0,0 +1,38 @@
+package v8
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
+	txfeeskeeper "github.com/osmosis-labs/osmosis/x/txfees/keeper"
+	txfeestypes "github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func CreateUpgradeHandler(mm *module.Manager, configurator module.Configurator,
+	gammSubspace paramstypes.Subspace,
+	txFeesKeeper *txfeeskeeper.Keeper,
+	lockupKeeper *lockupkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		// configure upgrade for gamm module's pool asset limit params add,
+		// leaving the governance set pool creation fee untouched.
+		gammSubspace.Set(ctx, gammtypes.KeyMinPoolAssets, uint64(gammtypes.MinPoolAssets))
+		gammSubspace.Set(ctx, gammtypes.KeyMaxPoolAssets, uint64(gammtypes.DefaultMaxPoolAssets))
+
+		// configure upgrade for the txfees module's params, which are new,
+		// with the base fee disabled until governance turns it on.
+		txFeesKeeper.SetParams(ctx, txfeestypes.DefaultParams())
+		txFeesKeeper.SetBaseFee(ctx, txfeestypes.DefaultMinBaseFee)
+
+		// configure upgrade for the lockup module's params, which are new,
+		// with force unlocking disabled until governance turns it on.
+		lockupKeeper.SetParams(ctx, lockuptypes.DefaultParams())
+
+		return mm.RunMigrations(ctx, configurator, vm)
+	}
+}
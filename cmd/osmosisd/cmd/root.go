@@ -128,6 +128,21 @@ arbitrage-min-gas-fee = ".005"
 # This is the minimum gas fee any tx with high gas demand should have, denominated in uosmo per gas
 # Default value of ".0025" then means that a tx with 1 million gas costs (.0025 uosmo/gas) * 1_000_000 gas = .0025 osmo
 min-gas-price-for-high-gas-tx = ".0025"
+
+# This is the window of the time weighted average price used to convert fees paid in non-osmo fee tokens into uosmo,
+# so that a fee token's price can't be moved within a block to pay less fees. Set to "0s" to use the spot price instead.
+fee-twap-window = "10m"
+
+# These are the minimum gas fee and the max gas wanted of any tx containing a message of the given type url,
+# letting expensive or spammy message types be priced differently. A tx pays the highest min gas fee and
+# gets the lowest max gas wanted of the message types it contains, and the above limits still apply.
+# Either of min-gas-price and max-gas-wanted may be left out. Messages wrapped in an authz MsgExec are
+# matched by their own type url, and the MsgExec itself by a policy for "/cosmos.authz.v1beta1.MsgExec".
+# Uncomment and repeat the table below for every message type to price.
+# [[osmosis-mempool.msg-gas-policy]]
+# msg-type-url = "/osmosis.gamm.v1beta1.MsgSwapExactAmountIn"
+# min-gas-price = ".01"
+# max-gas-wanted = "2000000"
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
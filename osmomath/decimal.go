@@ -0,0 +1,572 @@
+package osmomath
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BigDec is a fixed point decimal with 36 decimal places, twice that of sdk.Dec.
+// It is meant for intermediate results of AMM math, where the 18 decimals of sdk.Dec
+// lose too much precision. Results are converted back to sdk.Dec with SDKDec.
+//
+// The implementation mirrors sdk.Dec, with the same rounding behaviour.
+//
+// NOTE: never use new(BigDec) or else we will panic on the nil embedded big.Int
+type BigDec struct {
+	i *big.Int
+}
+
+const (
+	// number of decimal places
+	Precision = 36
+
+	// bytes required to represent the above precision
+	// Ceiling[Log2[10^Precision - 1]]
+	DecimalPrecisionBits = 120
+
+	// maximum bit length of the sdk.Int underlying sdk.Dec, kept for overflow checks
+	maxBitLen = 256
+
+	maxDecBitLen = maxBitLen + DecimalPrecisionBits
+
+	// sdk.Dec has 18 decimal places, so we chop this many digits when converting to it
+	sdkDecPrecisionDiff = Precision - sdk.Precision
+)
+
+var (
+	precisionReuse       = new(big.Int).Exp(big.NewInt(10), big.NewInt(Precision), nil)
+	fivePrecision        = new(big.Int).Quo(precisionReuse, big.NewInt(2))
+	precisionMultipliers = calcPrecisionMultipliers()
+	zeroInt              = big.NewInt(0)
+	oneInt               = big.NewInt(1)
+	tenInt               = big.NewInt(10)
+
+	sdkDecPrecisionMultiplier = new(big.Int).Exp(big.NewInt(10), big.NewInt(sdkDecPrecisionDiff), nil)
+)
+
+// Decimal errors
+var (
+	ErrEmptyDecimalStr      = errors.New("decimal string cannot be empty")
+	ErrInvalidDecimalLength = errors.New("invalid decimal length")
+	ErrInvalidDecimalStr    = errors.New("invalid decimal string")
+)
+
+// Set precision multipliers. This is done in a var initializer rather than init(),
+// so that other package level vars may already construct BigDecs.
+func calcPrecisionMultipliers() []*big.Int {
+	multipliers := make([]*big.Int, Precision+1)
+	for i := 0; i <= Precision; i++ {
+		multipliers[i] = calcPrecisionMultiplier(int64(i))
+	}
+	return multipliers
+}
+
+func precisionInt() *big.Int {
+	return new(big.Int).Set(precisionReuse)
+}
+
+func ZeroDec() BigDec     { return BigDec{new(big.Int).Set(zeroInt)} }
+func OneDec() BigDec      { return BigDec{precisionInt()} }
+func SmallestDec() BigDec { return BigDec{new(big.Int).Set(oneInt)} }
+
+// calculate the precision multiplier
+func calcPrecisionMultiplier(prec int64) *big.Int {
+	if prec > Precision {
+		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", Precision, prec))
+	}
+	zerosToAdd := Precision - prec
+	multiplier := new(big.Int).Exp(tenInt, big.NewInt(zerosToAdd), nil)
+	return multiplier
+}
+
+// get the precision multiplier, do not mutate result
+func precisionMultiplier(prec int64) *big.Int {
+	if prec > Precision {
+		panic(fmt.Sprintf("too much precision, maximum %v, provided %v", Precision, prec))
+	}
+	return precisionMultipliers[prec]
+}
+
+// create a new BigDec from integer assuming whole number
+func NewBigDec(i int64) BigDec {
+	return NewDecWithPrec(i, 0)
+}
+
+// create a new BigDec from integer with decimal place at prec
+// CONTRACT: prec <= Precision
+func NewDecWithPrec(i, prec int64) BigDec {
+	return BigDec{
+		new(big.Int).Mul(big.NewInt(i), precisionMultiplier(prec)),
+	}
+}
+
+// create a new BigDec from big integer assuming whole numbers
+func NewDecFromBigInt(i *big.Int) BigDec {
+	return NewDecFromBigIntWithPrec(i, 0)
+}
+
+// create a new BigDec from big integer with decimal place at prec
+// CONTRACT: prec <= Precision
+func NewDecFromBigIntWithPrec(i *big.Int, prec int64) BigDec {
+	return BigDec{
+		new(big.Int).Mul(i, precisionMultiplier(prec)),
+	}
+}
+
+// create a new BigDec from sdk.Int assuming whole numbers
+func NewDecFromInt(i sdk.Int) BigDec {
+	return NewDecFromBigIntWithPrec(i.BigInt(), 0)
+}
+
+// BigDecFromSDKDec converts an sdk.Dec to a BigDec, this is always exact.
+func BigDecFromSDKDec(d sdk.Dec) BigDec {
+	return NewDecFromBigIntWithPrec(d.BigInt(), sdk.Precision)
+}
+
+// create a decimal from an input decimal string.
+// valid must come in the form: (-) whole integers (.) decimal integers
+// examples of acceptable input include: -123.456, 456.7890, 345, -456789
+//
+// NOTE - An error will return if more decimal places
+// are provided in the string than the constant Precision.
+//
+// CONTRACT - This function does not mutate the input str.
+func NewDecFromStr(str string) (BigDec, error) {
+	if len(str) == 0 {
+		return BigDec{}, ErrEmptyDecimalStr
+	}
+
+	// first extract any negative symbol
+	neg := false
+	if str[0] == '-' {
+		neg = true
+		str = str[1:]
+	}
+
+	if len(str) == 0 {
+		return BigDec{}, ErrEmptyDecimalStr
+	}
+
+	strs := strings.Split(str, ".")
+	lenDecs := 0
+	combinedStr := strs[0]
+
+	if len(strs) == 2 { // has a decimal place
+		lenDecs = len(strs[1])
+		if lenDecs == 0 || len(combinedStr) == 0 {
+			return BigDec{}, ErrInvalidDecimalLength
+		}
+		combinedStr += strs[1]
+	} else if len(strs) > 2 {
+		return BigDec{}, ErrInvalidDecimalStr
+	}
+
+	if lenDecs > Precision {
+		return BigDec{}, fmt.Errorf("invalid precision; max: %d, got: %d", Precision, lenDecs)
+	}
+
+	// add some extra zero's to correct to the Precision factor
+	zerosToAdd := Precision - lenDecs
+	zeros := fmt.Sprintf(`%0`+strconv.Itoa(zerosToAdd)+`s`, "")
+	combinedStr += zeros
+
+	combined, ok := new(big.Int).SetString(combinedStr, 10) // base 10
+	if !ok {
+		return BigDec{}, fmt.Errorf("failed to set decimal string: %s", combinedStr)
+	}
+	if combined.BitLen() > maxDecBitLen {
+		return BigDec{}, fmt.Errorf("decimal out of range; bitLen: got %d, max %d", combined.BitLen(), maxDecBitLen)
+	}
+	if neg {
+		combined = new(big.Int).Neg(combined)
+	}
+
+	return BigDec{combined}, nil
+}
+
+// Decimal from string, panic on error
+func MustNewDecFromStr(s string) BigDec {
+	dec, err := NewDecFromStr(s)
+	if err != nil {
+		panic(err)
+	}
+	return dec
+}
+
+func (d BigDec) IsNil() bool          { return d.i == nil }                    // is decimal nil
+func (d BigDec) IsZero() bool         { return (d.i).Sign() == 0 }             // is equal to zero
+func (d BigDec) IsNegative() bool     { return (d.i).Sign() == -1 }            // is negative
+func (d BigDec) IsPositive() bool     { return (d.i).Sign() == 1 }             // is positive
+func (d BigDec) Equal(d2 BigDec) bool { return (d.i).Cmp(d2.i) == 0 }          // equal decimals
+func (d BigDec) GT(d2 BigDec) bool    { return (d.i).Cmp(d2.i) > 0 }           // greater than
+func (d BigDec) GTE(d2 BigDec) bool   { return (d.i).Cmp(d2.i) >= 0 }          // greater than or equal
+func (d BigDec) LT(d2 BigDec) bool    { return (d.i).Cmp(d2.i) < 0 }           // less than
+func (d BigDec) LTE(d2 BigDec) bool   { return (d.i).Cmp(d2.i) <= 0 }          // less than or equal
+func (d BigDec) Neg() BigDec          { return BigDec{new(big.Int).Neg(d.i)} } // reverse the decimal sign
+func (d BigDec) NegMut() BigDec       { d.i.Neg(d.i); return d }               // reverse the decimal sign, mutable
+func (d BigDec) Abs() BigDec          { return BigDec{new(big.Int).Abs(d.i)} } // absolute value
+func (d BigDec) Set(d2 BigDec) BigDec { d.i.Set(d2.i); return d }              // set to existing dec value
+func (d BigDec) Clone() BigDec        { return BigDec{new(big.Int).Set(d.i)} } // clone new dec
+
+// BigInt returns a copy of the underlying big.Int.
+func (d BigDec) BigInt() *big.Int {
+	if d.IsNil() {
+		return nil
+	}
+
+	cp := new(big.Int)
+	return cp.Set(d.i)
+}
+
+// addition
+func (d BigDec) Add(d2 BigDec) BigDec {
+	return d.Clone().AddMut(d2)
+}
+
+// mutable addition
+func (d BigDec) AddMut(d2 BigDec) BigDec {
+	d.i.Add(d.i, d2.i)
+
+	if d.i.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return d
+}
+
+// subtraction
+func (d BigDec) Sub(d2 BigDec) BigDec {
+	return d.Clone().SubMut(d2)
+}
+
+// mutable subtraction
+func (d BigDec) SubMut(d2 BigDec) BigDec {
+	d.i.Sub(d.i, d2.i)
+
+	if d.i.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return d
+}
+
+// multiplication
+func (d BigDec) Mul(d2 BigDec) BigDec {
+	return d.Clone().MulMut(d2)
+}
+
+// mutable multiplication
+func (d BigDec) MulMut(d2 BigDec) BigDec {
+	d.i.Mul(d.i, d2.i)
+	chopPrecisionAndRound(d.i)
+
+	if d.i.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return d
+}
+
+// multiplication truncate
+func (d BigDec) MulTruncate(d2 BigDec) BigDec {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	chopPrecisionAndTruncate(mul)
+
+	if mul.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{mul}
+}
+
+// multiplication
+func (d BigDec) MulInt(i sdk.Int) BigDec {
+	mul := new(big.Int).Mul(d.i, i.BigInt())
+
+	if mul.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{mul}
+}
+
+// MulInt64 - multiplication with int64
+func (d BigDec) MulInt64(i int64) BigDec {
+	mul := new(big.Int).Mul(d.i, big.NewInt(i))
+
+	if mul.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{mul}
+}
+
+// quotient
+func (d BigDec) Quo(d2 BigDec) BigDec {
+	return d.Clone().QuoMut(d2)
+}
+
+// mutable quotient
+func (d BigDec) QuoMut(d2 BigDec) BigDec {
+	// multiply precision twice
+	d.i.Mul(d.i, precisionReuse)
+	d.i.Mul(d.i, precisionReuse)
+	d.i.Quo(d.i, d2.i)
+
+	chopPrecisionAndRound(d.i)
+	if d.i.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return d
+}
+
+// quotient truncate
+func (d BigDec) QuoTruncate(d2 BigDec) BigDec {
+	// multiply precision twice
+	quo := new(big.Int).Mul(d.i, precisionReuse)
+	quo.Mul(quo, precisionReuse)
+	quo.Quo(quo, d2.i)
+
+	chopPrecisionAndTruncate(quo)
+	if quo.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{quo}
+}
+
+// quotient
+func (d BigDec) QuoInt(i sdk.Int) BigDec {
+	return BigDec{new(big.Int).Quo(d.i, i.BigInt())}
+}
+
+// QuoInt64 - quotient with int64
+func (d BigDec) QuoInt64(i int64) BigDec {
+	return BigDec{new(big.Int).Quo(d.i, big.NewInt(i))}
+}
+
+// Power returns a the result of raising to a positive integer power
+func (d BigDec) Power(power uint64) BigDec {
+	if power == 0 {
+		return OneDec()
+	}
+	res := d.Clone()
+	tmp := OneDec()
+
+	for i := power; i > 1; {
+		if i%2 != 0 {
+			tmp.MulMut(res)
+		}
+		i /= 2
+		res.MulMut(res)
+	}
+
+	return res.MulMut(tmp)
+}
+
+// is integer, e.g. decimals are zero
+func (d BigDec) IsInteger() bool {
+	return new(big.Int).Rem(d.i, precisionReuse).Sign() == 0
+}
+
+// format decimal state
+func (d BigDec) Format(s fmt.State, verb rune) {
+	_, err := s.Write([]byte(d.String()))
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (d BigDec) String() string {
+	if d.i == nil {
+		return d.i.String()
+	}
+
+	isNeg := d.IsNegative()
+
+	if isNeg {
+		d = d.Neg()
+	}
+
+	bzInt, err := d.i.MarshalText()
+	if err != nil {
+		return ""
+	}
+	inputSize := len(bzInt)
+
+	var bzStr []byte
+
+	// case 1, purely decimal
+	if inputSize <= Precision {
+		bzStr = make([]byte, Precision+2)
+
+		// 0. prefix
+		bzStr[0] = byte('0')
+		bzStr[1] = byte('.')
+
+		// set relevant digits to 0
+		for i := 0; i < Precision-inputSize; i++ {
+			bzStr[i+2] = byte('0')
+		}
+
+		// set final digits
+		copy(bzStr[2+(Precision-inputSize):], bzInt)
+	} else {
+		// inputSize + 1 to account for the decimal point that is being added
+		bzStr = make([]byte, inputSize+1)
+		decPointPlace := inputSize - Precision
+
+		copy(bzStr, bzInt[:decPointPlace])                   // pre-decimal digits
+		bzStr[decPointPlace] = byte('.')                     // decimal point
+		copy(bzStr[decPointPlace+1:], bzInt[decPointPlace:]) // post-decimal digits
+	}
+
+	if isNeg {
+		return "-" + string(bzStr)
+	}
+
+	return string(bzStr)
+}
+
+// MarshalJSON marshals the decimal as a string, the same as sdk.Dec.
+func (d BigDec) MarshalJSON() ([]byte, error) {
+	if d.i == nil {
+		return json.Marshal(ZeroDec().String())
+	}
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON defines custom decoding scheme
+func (d *BigDec) UnmarshalJSON(bz []byte) error {
+	var text string
+	err := json.Unmarshal(bz, &text)
+	if err != nil {
+		return err
+	}
+
+	newDec, err := NewDecFromStr(text)
+	if err != nil {
+		return err
+	}
+
+	d.i = newDec.i
+	return nil
+}
+
+// Remove a Precision amount of rightmost digits and perform bankers rounding
+// on the remainder (gaussian rounding) on the digits which have been removed.
+//
+// Mutates the input.
+func chopPrecisionAndRound(d *big.Int) *big.Int {
+	return chopAndRound(d, precisionReuse, fivePrecision)
+}
+
+// chopAndRound divides d by divisor, performing bankers rounding.
+// half must be divisor / 2. Mutates the input.
+func chopAndRound(d *big.Int, divisor *big.Int, half *big.Int) *big.Int {
+	// remove the negative and add it back when returning
+	if d.Sign() == -1 {
+		// make d positive, compute chopped value, and then un-mutate d
+		d = d.Neg(d)
+		d = chopAndRound(d, divisor, half)
+		d = d.Neg(d)
+		return d
+	}
+
+	// get the truncated quotient and remainder
+	quo, rem := d, big.NewInt(0)
+	quo, rem = quo.QuoRem(d, divisor, rem)
+
+	if rem.Sign() == 0 { // remainder is zero
+		return quo
+	}
+
+	switch rem.Cmp(half) {
+	case -1:
+		return quo
+	case 1:
+		return quo.Add(quo, oneInt)
+	default: // bankers rounding must take place
+		// always round to an even number
+		if quo.Bit(0) == 0 {
+			return quo
+		}
+		return quo.Add(quo, oneInt)
+	}
+}
+
+// chopPrecisionAndTruncate is similar to chopPrecisionAndRound,
+// but always rounds towards zero. Mutates the input.
+func chopPrecisionAndTruncate(d *big.Int) {
+	d.Quo(d, precisionReuse)
+}
+
+// RoundInt round the decimal using bankers rounding
+func (d BigDec) RoundInt() sdk.Int {
+	return sdk.NewIntFromBigInt(chopPrecisionAndRound(d.BigInt()))
+}
+
+// TruncateInt truncates the decimals from the number and returns an sdk.Int
+func (d BigDec) TruncateInt() sdk.Int {
+	tmp := d.BigInt()
+	chopPrecisionAndTruncate(tmp)
+	return sdk.NewIntFromBigInt(tmp)
+}
+
+// TruncateDec truncates the decimals from the number and returns a BigDec
+func (d BigDec) TruncateDec() BigDec {
+	tmp := d.BigInt()
+	chopPrecisionAndTruncate(tmp)
+	return NewDecFromBigInt(tmp)
+}
+
+// Ceil returns the smallest interger value (as a decimal) that is greater than
+// or equal to the given decimal.
+func (d BigDec) Ceil() BigDec {
+	tmp := new(big.Int).Set(d.i)
+
+	quo, rem := tmp, big.NewInt(0)
+	quo, rem = quo.QuoRem(tmp, precisionReuse, rem)
+
+	// no need to round with a zero remainder regardless of sign
+	if rem.Sign() == 0 {
+		return NewDecFromBigInt(quo)
+	}
+
+	if rem.Sign() == -1 {
+		return NewDecFromBigInt(quo)
+	}
+
+	return NewDecFromBigInt(quo.Add(quo, oneInt))
+}
+
+// SDKDec returns the sdk.Dec representation of the BigDec,
+// truncating (towards zero) the decimal places sdk.Dec can not hold.
+func (d BigDec) SDKDec() sdk.Dec {
+	tmp := new(big.Int).Quo(d.i, sdkDecPrecisionMultiplier)
+	return sdk.NewDecFromBigIntWithPrec(tmp, sdk.Precision)
+}
+
+// SDKDecRounded returns the sdk.Dec representation of the BigDec,
+// using bankers rounding on the decimal places sdk.Dec can not hold.
+// This matches the rounding of sdk.Dec's own Mul and Quo.
+func (d BigDec) SDKDecRounded() sdk.Dec {
+	half := new(big.Int).Quo(sdkDecPrecisionMultiplier, big.NewInt(2))
+	tmp := chopAndRound(d.BigInt(), sdkDecPrecisionMultiplier, half)
+	return sdk.NewDecFromBigIntWithPrec(tmp, sdk.Precision)
+}
+
+// MinDec returns the minimum decimal between two
+func MinDec(d1, d2 BigDec) BigDec {
+	if d1.LT(d2) {
+		return d1
+	}
+	return d2
+}
+
+// MaxDec returns the maximum decimal between two
+func MaxDec(d1, d2 BigDec) BigDec {
+	if d1.LT(d2) {
+		return d2
+	}
+	return d1
+}
@@ -0,0 +1,101 @@
+package osmomath
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDecFromStr(t *testing.T) {
+	tests := []struct {
+		input      string
+		expected   string
+		expectPass bool
+	}{
+		{"0", "0.000000000000000000000000000000000000", true},
+		{"1", "1.000000000000000000000000000000000000", true},
+		{"-12.5", "-12.500000000000000000000000000000000000", true},
+		{"0.000000000000000000000000000000000001", "0.000000000000000000000000000000000001", true},
+		{"0.0000000000000000000000000000000000001", "", false},
+		{"", "", false},
+		{"-", "", false},
+		{"1.", "", false},
+		{".1", "", false},
+		{"1.1.1", "", false},
+		{"foo", "", false},
+	}
+
+	for _, test := range tests {
+		dec, err := NewDecFromStr(test.input)
+		if !test.expectPass {
+			require.Error(t, err, "input: %v", test.input)
+			continue
+		}
+		require.NoError(t, err, "input: %v", test.input)
+		require.Equal(t, test.expected, dec.String(), "input: %v", test.input)
+	}
+}
+
+func TestBigDecArithmetic(t *testing.T) {
+	a := MustNewDecFromStr("3.5")
+	b := MustNewDecFromStr("0.25")
+
+	require.Equal(t, MustNewDecFromStr("3.75"), a.Add(b))
+	require.Equal(t, MustNewDecFromStr("3.25"), a.Sub(b))
+	require.Equal(t, MustNewDecFromStr("0.875"), a.Mul(b))
+	require.Equal(t, MustNewDecFromStr("14"), a.Quo(b))
+	require.Equal(t, MustNewDecFromStr("42.875"), a.Power(3))
+	require.Equal(t, MustNewDecFromStr("7"), a.MulInt(sdk.NewInt(2)))
+	require.Equal(t, MustNewDecFromStr("1.75"), a.QuoInt64(2))
+
+	// the inputs are not mutated
+	require.Equal(t, MustNewDecFromStr("3.5"), a)
+	require.Equal(t, MustNewDecFromStr("0.25"), b)
+
+	require.True(t, a.GT(b))
+	require.True(t, b.LT(a))
+	require.True(t, a.Neg().IsNegative())
+	require.Equal(t, a, a.Neg().Abs())
+}
+
+func TestBigDecRounding(t *testing.T) {
+	oneThird := OneDec().Quo(NewBigDec(3))
+	twoThirds := NewBigDec(2).Quo(NewBigDec(3))
+
+	require.Equal(t, "0.333333333333333333333333333333333333", oneThird.String())
+	require.Equal(t, "0.666666666666666666666666666666666667", twoThirds.String())
+	require.Equal(t, "0.666666666666666666666666666666666666", NewBigDec(2).QuoTruncate(NewBigDec(3)).String())
+
+	require.Equal(t, "0", oneThird.RoundInt().String())
+	require.Equal(t, "1", twoThirds.RoundInt().String())
+	require.Equal(t, "0", twoThirds.TruncateInt().String())
+	require.True(t, OneDec().Equal(twoThirds.Ceil()))
+	require.True(t, ZeroDec().Equal(twoThirds.Neg().Ceil()))
+
+	// bankers rounding
+	require.Equal(t, "2", MustNewDecFromStr("2.5").RoundInt().String())
+	require.Equal(t, "4", MustNewDecFromStr("3.5").RoundInt().String())
+}
+
+func TestSDKDecConversion(t *testing.T) {
+	sdkDec := sdk.MustNewDecFromStr("123.456789012345678901")
+	require.Equal(t, sdkDec, BigDecFromSDKDec(sdkDec).SDKDec())
+	require.Equal(t, sdkDec, BigDecFromSDKDec(sdkDec).SDKDecRounded())
+
+	twoThirds := NewBigDec(2).Quo(NewBigDec(3))
+	require.Equal(t, sdk.MustNewDecFromStr("0.666666666666666666"), twoThirds.SDKDec())
+	require.Equal(t, sdk.MustNewDecFromStr("0.666666666666666667"), twoThirds.SDKDecRounded())
+	require.Equal(t, sdk.MustNewDecFromStr("-0.666666666666666666"), twoThirds.Neg().SDKDec())
+	require.Equal(t, sdk.MustNewDecFromStr("-0.666666666666666667"), twoThirds.Neg().SDKDecRounded())
+
+	// Dividing in BigDec and then converting is more precise than dividing in sdk.Dec,
+	// as sdk.Dec rounds the intermediate result.
+	x := sdk.NewDec(1000000000000)
+	sdkResult := x.Quo(x.Add(sdk.OneDec())).Mul(x.Add(sdk.OneDec()))
+	bigX := BigDecFromSDKDec(x)
+	bigResult := bigX.Quo(bigX.Add(OneDec())).Mul(bigX.Add(OneDec())).SDKDecRounded()
+	require.Equal(t, x, bigResult)
+	require.NotEqual(t, x, sdkResult)
+}
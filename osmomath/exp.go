@@ -0,0 +1,118 @@
+package osmomath
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// ln(2) to the 36 decimal places of BigDec.
+var ln2 = MustNewDecFromStr("0.693147180559945309417232121458176568")
+
+// maxExpArg is the largest argument of Exp. e^170 is just below 2^246,
+// which leaves room for the decimals of BigDec within the overflow limit.
+var maxExpArg = NewBigDec(170)
+
+// minExpArg is the argument of Exp below which the result is less than
+// the smallest BigDec, and so rounds to zero.
+var minExpArg = NewBigDec(-84)
+
+// Exp computes e^x.
+//
+// x is split as x = k * ln(2) + r, with k an integer and |r| <= ln(2) / 2,
+// so e^x = 2^k * e^r. e^r is computed with its Taylor series, which for this
+// range of r reaches the 36 decimal places of BigDec within 25 terms.
+//
+// The relative error of the result is below 10^-33, or the result is
+// within 10^-36 of the exact value when that is smaller.
+// Panics if x > 170.
+func Exp(x BigDec) BigDec {
+	if x.GT(maxExpArg) {
+		panic(fmt.Errorf("exp argument must be lesser than %s", maxExpArg))
+	}
+	if x.LT(minExpArg) {
+		return ZeroDec()
+	}
+
+	k := x.Quo(ln2).RoundInt().Int64()
+	r := x.Sub(ln2.MulInt64(k))
+
+	// e^r = sum_{n=0}^{infty} r^n / n!
+	sum := OneDec()
+	term := OneDec()
+	for n := int64(1); ; n++ {
+		term.MulMut(r).QuoMut(NewBigDec(n))
+		if term.IsZero() {
+			break
+		}
+		sum.AddMut(term)
+	}
+
+	// multiply by 2^k
+	if k > 0 {
+		sum.i.Lsh(sum.i, uint(k))
+	} else if k < 0 {
+		divisor := new(big.Int).Lsh(oneInt, uint(-k))
+		half := new(big.Int).Rsh(divisor, 1)
+		chopAndRound(sum.i, divisor, half)
+	}
+	return sum
+}
+
+// Ln computes the natural logarithm of x.
+//
+// x is split as x = 2^k * m, with k an integer and 1 <= m < 2,
+// so ln(x) = k * ln(2) + ln(m). ln(m) is computed with the series
+// ln(m) = 2 * atanh(z) = 2 * sum_{n=0}^{infty} z^(2n+1) / (2n+1), where z = (m - 1) / (m + 1) < 1/3,
+// which reaches the 36 decimal places of BigDec within 40 terms.
+//
+// The absolute error of the result is below 10^-33.
+// Panics if x is not positive.
+func Ln(x BigDec) BigDec {
+	if !x.IsPositive() {
+		panic(fmt.Errorf("ln argument must be greater than 0"))
+	}
+
+	// find k and m, shifting the underlying integer is exact for k < 0,
+	// and truncates the last digit for k > 0, a relative error of at most 10^-36.
+	m := x.Clone()
+	k := int64(0)
+	twoPrecision := new(big.Int).Lsh(precisionReuse, 1)
+	for m.i.Cmp(twoPrecision) >= 0 {
+		m.i.Rsh(m.i, 1)
+		k++
+	}
+	for m.i.Cmp(precisionReuse) < 0 {
+		m.i.Lsh(m.i, 1)
+		k--
+	}
+
+	z := m.Sub(OneDec()).Quo(m.Add(OneDec()))
+	zSquared := z.Mul(z)
+
+	sum := z.Clone()
+	power := z.Clone()
+	for n := int64(3); ; n += 2 {
+		power.MulMut(zSquared)
+		term := power.QuoInt64(n)
+		if term.IsZero() {
+			break
+		}
+		sum.AddMut(term)
+	}
+
+	return sum.MulInt64(2).AddMut(ln2.MulInt64(k))
+}
+
+// LnExpPow computes base^exp as e^(exp * ln(base)).
+//
+// Unlike Pow, it is not restricted to bases lesser than two, and its
+// precision does not depend on a series precision parameter.
+// The relative error of the result is below (1 + |exp|) * 10^-33,
+// where exp * ln(base) is within the domain of Exp.
+// Panics if base is not positive.
+func LnExpPow(base BigDec, exp BigDec) BigDec {
+	if exp.IsZero() {
+		return OneDec()
+	}
+	return Exp(exp.Mul(Ln(base)))
+}
@@ -0,0 +1,159 @@
+package osmomath
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// maxErrorBound is the 10^-33 used in the documented error bounds.
+var maxErrorBound = MustNewDecFromStr("0.000000000000000000000000000000001")
+
+// requireWithinError checks |expected - actual| <= max(|expected| * relativeError, absoluteError).
+func requireWithinError(t *testing.T, expected, actual, relativeError, absoluteError BigDec, msg string) {
+	bound := MaxDec(expected.Abs().Mul(relativeError), absoluteError)
+	require.True(t, expected.Sub(actual).Abs().LTE(bound), "%s: expected %s, got %s", msg, expected, actual)
+}
+
+// The expected values are computed with Python's decimal module at 150 digits of precision,
+// and rounded to 36 decimal places.
+func TestExp(t *testing.T) {
+	tests := []struct {
+		x        string
+		expected string
+	}{
+		{"0", "1"},
+		{"1", "2.718281828459045235360287471352662498"},
+		{"-1", "0.367879441171442321595523770161460867"},
+		{"0.5", "1.648721270700128146848650787814163572"},
+		{"10", "22026.465794806716516957900645284244366354"},
+		{"-10", "0.000045399929762484851535591515560551"},
+		{"100", "26881171418161354484126255515800135873611118.773741922415191608615280287034909565"},
+		{"0.000001", "1.000001000000500000166666708333341667"},
+		{"-50", "0.000000000000000000000192874984796392"},
+		{"170", "67617938104850097226297739817614724024738844076245781586034419399019174913.451893736937485871658201479248009009"},
+		{"-100", "0"},
+	}
+
+	for _, test := range tests {
+		actual := Exp(MustNewDecFromStr(test.x))
+		requireWithinError(t, MustNewDecFromStr(test.expected), actual, maxErrorBound, SmallestDec(), "x: "+test.x)
+	}
+
+	require.Panics(t, func() { Exp(MustNewDecFromStr("170.000000000000000000000000000000000001")) })
+}
+
+func TestLn(t *testing.T) {
+	tests := []struct {
+		x        string
+		expected string
+	}{
+		{"1", "0"},
+		{"2", "0.693147180559945309417232121458176568"},
+		{"10", "2.302585092994045684017991454684364208"},
+		{"0.5", "-0.693147180559945309417232121458176568"},
+		{"0.000000000000000001", "-41.446531673892822312323846184318555737"},
+		{"123456789.123456789", "18.631401767168018032693933348296537543"},
+		{"1000000000000000000000000000000", "69.077552789821370520539743640530926228"},
+	}
+
+	for _, test := range tests {
+		actual := Ln(MustNewDecFromStr(test.x))
+		requireWithinError(t, MustNewDecFromStr(test.expected), actual, ZeroDec(), maxErrorBound, "x: "+test.x)
+	}
+
+	require.Panics(t, func() { Ln(ZeroDec()) })
+	require.Panics(t, func() { Ln(NewBigDec(-1)) })
+}
+
+func TestExpLnInverse(t *testing.T) {
+	for _, x := range []string{"0.000000000001", "0.3", "1.5", "7", "1000", "98765432.1"} {
+		dec := MustNewDecFromStr(x)
+		requireWithinError(t, dec, Exp(Ln(dec)), maxErrorBound, SmallestDec(), "x: "+x)
+	}
+}
+
+func TestNthRoot(t *testing.T) {
+	tests := []struct {
+		x        string
+		n        uint64
+		expected string
+	}{
+		{"2", 2, "1.414213562373095048801688724209698079"},
+		{"2", 3, "1.259921049894873164767210607278228351"},
+		{"0.000001", 2, "0.001"},
+		{"1000000000000000000", 7, "372.759372031494016617249060947304099208"},
+		{"12345.6789", 5, "6.581168274138339817912622962567798140"},
+		{"16", 4, "2"},
+		{"0", 3, "0"},
+		{"5", 1, "5"},
+	}
+
+	for _, test := range tests {
+		actual := NthRoot(MustNewDecFromStr(test.x), test.n)
+		requireWithinError(t, MustNewDecFromStr(test.expected), actual, maxErrorBound, ZeroDec(), fmt.Sprintf("x: %s, n: %d", test.x, test.n))
+	}
+
+	requireWithinError(t, MustNewDecFromStr("1.414213562373095048801688724209698079"), Sqrt(NewBigDec(2)), maxErrorBound, ZeroDec(), "sqrt")
+
+	require.Panics(t, func() { NthRoot(NewBigDec(-1), 2) })
+	require.Panics(t, func() { NthRoot(NewBigDec(2), 0) })
+}
+
+func TestLnExpPow(t *testing.T) {
+	tests := []struct {
+		base     string
+		exp      string
+		expected string
+	}{
+		{"1.68", "0.32", "1.180589646264155865832081116933768971"},
+		{"0.8", "0.32", "0.931083854556026322203027493678276272"},
+		{"0.000249", "2.304", "0.000000004975575214099077987947699783"},
+		{"1.234", "120.3", "96652637907.128788236903533729436126411888483036"},
+		{"5", "0.5", "2.236067977499789696409173668731276235"},
+		{"1000", "1.5", "31622.776601683793319988935444327185337196"},
+		{"3", "0", "1"},
+		// Pow is off by more than its precision here, see TestLnExpPowMatchesPow
+		{"0.1", "0.00000492", "0.999988671345512160185623201105294995"},
+	}
+
+	for _, test := range tests {
+		exp := MustNewDecFromStr(test.exp)
+		actual := LnExpPow(MustNewDecFromStr(test.base), exp)
+		bound := OneDec().Add(exp).Mul(maxErrorBound)
+		requireWithinError(t, MustNewDecFromStr(test.expected), actual, bound, SmallestDec(), fmt.Sprintf("base: %s, exp: %s", test.base, test.exp))
+	}
+}
+
+// LnExpPow agrees with the series expansion of Pow, to within Pow's precision.
+// This only holds for some inputs, for bases far from one like 0.1 the series
+// can stop early while its error is still above the precision.
+func TestLnExpPowMatchesPow(t *testing.T) {
+	tests := []struct {
+		base string
+		exp  string
+	}{
+		{"1.2", "1.2"},
+		{"0.5", "11.122"},
+		{"0.493", "0.00000121"},
+		{"0.000249", "2.304"},
+		{"1.65976735939", "0.5"},
+	}
+
+	for _, test := range tests {
+		base := sdk.MustNewDecFromStr(test.base)
+		exp := sdk.MustNewDecFromStr(test.exp)
+
+		expected := Pow(base, exp)
+		actual := LnExpPow(BigDecFromSDKDec(base), BigDecFromSDKDec(exp)).SDKDecRounded()
+
+		require.True(
+			t,
+			expected.Sub(actual).Abs().LTE(powPrecision),
+			"base: %v, exp: %v, expected %v, got %v", test.base, test.exp, expected, actual,
+		)
+	}
+}
@@ -0,0 +1,57 @@
+package osmomath
+
+import (
+	"fmt"
+)
+
+// maxRootIterations bounds the number of Newton iterations of NthRoot.
+// Starting from e^(ln(x) / n), which is already within 10^-33 of the root,
+// each iteration doubles the number of correct digits, so this is never reached
+// other than by oscillating in the last decimal place.
+const maxRootIterations = 10
+
+// NthRoot computes the positive real nth root of x.
+//
+// The initial guess e^(ln(x) / n) is refined with Newton's method on
+// f(y) = y^n - x, that is y' = ((n - 1) * y + x / y^(n-1)) / n,
+// until an iteration changes the guess by at most 10^-36.
+//
+// The relative error of the result is below 10^-33.
+// Panics if x is negative or n is zero.
+func NthRoot(x BigDec, n uint64) BigDec {
+	if x.IsNegative() {
+		panic(fmt.Errorf("root argument must not be negative"))
+	}
+	if n == 0 {
+		panic(fmt.Errorf("root must be greater than 0"))
+	}
+	if n == 1 || x.IsZero() {
+		return x.Clone()
+	}
+
+	guess := Exp(Ln(x).QuoInt64(int64(n)))
+	if guess.IsZero() {
+		guess = SmallestDec()
+	}
+
+	nMinusOne := int64(n - 1)
+	for i := 0; i < maxRootIterations; i++ {
+		prev := guess.Power(n - 1)
+		if prev.IsZero() {
+			break
+		}
+		next := guess.MulInt64(nMinusOne).AddMut(x.Quo(prev)).QuoInt64(int64(n))
+		delta := next.Sub(guess).Abs()
+		guess = next
+		if delta.LTE(SmallestDec()) {
+			break
+		}
+	}
+
+	return guess
+}
+
+// Sqrt computes the square root of x, see NthRoot.
+func Sqrt(x BigDec) BigDec {
+	return NthRoot(x, 2)
+}
@@ -0,0 +1,146 @@
+package osmomath
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The functions in this file round in an explicit direction,
+// RoundUp always rounds towards positive infinity, and RoundDown towards negative infinity.
+// This differs from Truncate, which rounds towards zero, for negative values.
+//
+// AMM math should use these to always round in the pool's favour,
+// e.g. rounding down tokens that leave the pool and rounding up tokens that enter it.
+
+// quoDirected returns num / den, rounded up or down.
+func quoDirected(num, den *big.Int, roundUp bool) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+
+	// QuoRem truncates towards zero, so the exact quotient is above quo
+	// when it is positive, and below quo when it is negative.
+	positive := rem.Sign() == den.Sign()
+	if roundUp && positive {
+		quo.Add(quo, oneInt)
+	} else if !roundUp && !positive {
+		quo.Sub(quo, oneInt)
+	}
+	return quo
+}
+
+// MulRoundUp multiplies, rounding towards positive infinity.
+func (d BigDec) MulRoundUp(d2 BigDec) BigDec {
+	return d.mulDirected(d2, true)
+}
+
+// MulRoundDown multiplies, rounding towards negative infinity.
+func (d BigDec) MulRoundDown(d2 BigDec) BigDec {
+	return d.mulDirected(d2, false)
+}
+
+func (d BigDec) mulDirected(d2 BigDec, roundUp bool) BigDec {
+	mul := new(big.Int).Mul(d.i, d2.i)
+	res := quoDirected(mul, precisionReuse, roundUp)
+
+	if res.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{res}
+}
+
+// QuoRoundUp divides, rounding towards positive infinity.
+func (d BigDec) QuoRoundUp(d2 BigDec) BigDec {
+	return d.quoDirected(d2, true)
+}
+
+// QuoRoundDown divides, rounding towards negative infinity.
+func (d BigDec) QuoRoundDown(d2 BigDec) BigDec {
+	return d.quoDirected(d2, false)
+}
+
+func (d BigDec) quoDirected(d2 BigDec, roundUp bool) BigDec {
+	num := new(big.Int).Mul(d.i, precisionReuse)
+	res := quoDirected(num, d2.i, roundUp)
+
+	if res.BitLen() > maxDecBitLen {
+		panic("Int overflow")
+	}
+	return BigDec{res}
+}
+
+// SDKDecRoundUp returns the sdk.Dec representation of the BigDec,
+// rounding towards positive infinity.
+func (d BigDec) SDKDecRoundUp() sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(quoDirected(d.i, sdkDecPrecisionMultiplier, true), sdk.Precision)
+}
+
+// SDKDecRoundDown returns the sdk.Dec representation of the BigDec,
+// rounding towards negative infinity.
+func (d BigDec) SDKDecRoundDown() sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(quoDirected(d.i, sdkDecPrecisionMultiplier, false), sdk.Precision)
+}
+
+// PowRoundUp computes base^exp, such that the result is never lesser than the exact value.
+// Contract: base > 0, exp >= 0
+func PowRoundUp(base BigDec, exp BigDec) BigDec {
+	return powDirected(base, exp, true)
+}
+
+// PowRoundDown computes base^exp, such that the result is never greater than the exact value.
+// Contract: base > 0, exp >= 0
+func PowRoundDown(base BigDec, exp BigDec) BigDec {
+	return powDirected(base, exp, false)
+}
+
+// powDirected computes integer powers by repeated multiplication, every step rounded
+// in the same direction, which is exact up to that rounding since base > 0.
+// Other powers are computed with LnExpPow, and moved by its maximum error
+// in the rounding direction.
+func powDirected(base BigDec, exp BigDec, roundUp bool) BigDec {
+	if !base.IsPositive() {
+		panic(fmt.Errorf("base must be greater than 0"))
+	}
+	if exp.IsNegative() {
+		panic(fmt.Errorf("exp must not be negative"))
+	}
+
+	if exp.IsInteger() {
+		return base.powerDirected(exp.TruncateInt().Uint64(), roundUp)
+	}
+
+	res := LnExpPow(base, exp)
+	// the relative error of LnExpPow is below (1 + exp) * 10^-33,
+	// and its absolute error below 10^-36 for results that small.
+	maxError := OneDec().Add(exp).MulRoundUp(lnExpPowErrorFactor)
+	if roundUp {
+		return res.MulRoundUp(OneDec().Add(maxError)).Add(SmallestDec())
+	}
+	res = res.MulRoundDown(OneDec().Sub(maxError)).Sub(SmallestDec())
+	if res.IsNegative() {
+		return ZeroDec()
+	}
+	return res
+}
+
+// 10^-33, the factor of the documented error bounds of Ln, Exp and LnExpPow.
+var lnExpPowErrorFactor = NewDecWithPrec(1, 33)
+
+func (d BigDec) powerDirected(power uint64, roundUp bool) BigDec {
+	res := OneDec()
+	base := d.Clone()
+
+	for i := power; i > 0; i /= 2 {
+		if i%2 != 0 {
+			res = res.mulDirected(base, roundUp)
+		}
+		if i > 1 {
+			base = base.mulDirected(base, roundUp)
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,118 @@
+package osmomath
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMulQuoDirected(t *testing.T) {
+	tests := []struct {
+		a, b           string
+		mulUp, mulDown string
+		quoUp, quoDown string
+	}{
+		{
+			a: "1", b: "3",
+			mulUp: "3", mulDown: "3",
+			quoUp:   "0.333333333333333333333333333333333334",
+			quoDown: "0.333333333333333333333333333333333333",
+		},
+		{
+			a: "-1", b: "3",
+			mulUp: "-3", mulDown: "-3",
+			quoUp:   "-0.333333333333333333333333333333333333",
+			quoDown: "-0.333333333333333333333333333333333334",
+		},
+		{
+			a: "0.000000000000000000000000000000000001", b: "0.5",
+			mulUp: "0.000000000000000000000000000000000001", mulDown: "0",
+			quoUp:   "0.000000000000000000000000000000000002",
+			quoDown: "0.000000000000000000000000000000000002",
+		},
+		{
+			a: "-0.000000000000000000000000000000000001", b: "0.5",
+			mulUp: "0", mulDown: "-0.000000000000000000000000000000000001",
+			quoUp:   "-0.000000000000000000000000000000000002",
+			quoDown: "-0.000000000000000000000000000000000002",
+		},
+		{
+			a: "2", b: "-3",
+			mulUp: "-6", mulDown: "-6",
+			quoUp:   "-0.666666666666666666666666666666666666",
+			quoDown: "-0.666666666666666666666666666666666667",
+		},
+	}
+
+	for _, test := range tests {
+		a, b := MustNewDecFromStr(test.a), MustNewDecFromStr(test.b)
+		require.True(t, MustNewDecFromStr(test.mulUp).Equal(a.MulRoundUp(b)), "%s * %s", test.a, test.b)
+		require.True(t, MustNewDecFromStr(test.mulDown).Equal(a.MulRoundDown(b)), "%s * %s", test.a, test.b)
+		require.True(t, MustNewDecFromStr(test.quoUp).Equal(a.QuoRoundUp(b)), "%s / %s", test.a, test.b)
+		require.True(t, MustNewDecFromStr(test.quoDown).Equal(a.QuoRoundDown(b)), "%s / %s", test.a, test.b)
+	}
+}
+
+func TestSDKDecDirected(t *testing.T) {
+	d := MustNewDecFromStr("1.000000000000000000000000000000000001")
+	require.Equal(t, "1.000000000000000001", d.SDKDecRoundUp().String())
+	require.Equal(t, "1.000000000000000000", d.SDKDecRoundDown().String())
+	require.Equal(t, "-1.000000000000000000", d.Neg().SDKDecRoundUp().String())
+	require.Equal(t, "-1.000000000000000001", d.Neg().SDKDecRoundDown().String())
+
+	exact := MustNewDecFromStr("2.5")
+	require.Equal(t, "2.500000000000000000", exact.SDKDecRoundUp().String())
+	require.Equal(t, "2.500000000000000000", exact.SDKDecRoundDown().String())
+}
+
+func TestPowDirected(t *testing.T) {
+	// The expected values are computed with Python's decimal module at 150 digits of precision,
+	// and rounded down and up to 36 decimal places.
+	tests := []struct {
+		base, exp   string
+		floor, ceil string
+	}{
+		// integer powers are computed by multiplication
+		{"1.1", "3", "1.331", "1.331"},
+		{"0.3", "2", "0.09", "0.09"},
+		{
+			"0.999999999999999999999999999999999999", "2",
+			"0.999999999999999999999999999999999998", "0.999999999999999999999999999999999999",
+		},
+		{
+			"1.2", "1.2",
+			"1.244564747203977721816915411182635320", "1.244564747203977721816915411182635321",
+		},
+		{
+			"0.5", "11.122",
+			"0.000448687931386254827052257527163123", "0.000448687931386254827052257527163124",
+		},
+		{
+			"0.1", "0.00000492",
+			"0.999988671345512160185623201105294995", "0.999988671345512160185623201105294996",
+		},
+		{
+			"1.234", "120.3",
+			"96652637907.128788236903533729436126411888483036", "96652637907.128788236903533729436126411888483037",
+		},
+	}
+
+	for _, test := range tests {
+		base, exp := MustNewDecFromStr(test.base), MustNewDecFromStr(test.exp)
+		floor, ceil := MustNewDecFromStr(test.floor), MustNewDecFromStr(test.ceil)
+		msg := test.base + "^" + test.exp
+
+		up := PowRoundUp(base, exp)
+		down := PowRoundDown(base, exp)
+		require.True(t, up.GTE(ceil), "%s: %s < %s", msg, up, ceil)
+		require.True(t, down.LTE(floor), "%s: %s > %s", msg, down, floor)
+
+		// twice the error bound of LnExpPow, as the result is moved by that bound
+		relativeError := OneDec().Add(exp).Mul(maxErrorBound).MulInt64(2)
+		requireWithinError(t, ceil, up, relativeError, SmallestDec().MulInt64(2), msg)
+		requireWithinError(t, floor, down, relativeError, SmallestDec().MulInt64(2), msg)
+	}
+
+	require.Panics(t, func() { PowRoundUp(ZeroDec(), OneDec()) })
+	require.Panics(t, func() { PowRoundDown(OneDec(), OneDec().Neg()) })
+}
@@ -0,0 +1,239 @@
+// Package solver implements deterministic numeric root finding on osmomath.BigDec,
+// for pool models whose invariant has no closed form solution for a reserve.
+//
+// All arithmetic is exact integer arithmetic on BigDec, the number of iterations is
+// bounded, and convergence is decided on the width of a bracket around the root,
+// so the result only depends on the inputs and is the same on every machine.
+// Rather than a single point, the solvers return a Bracket that is known to contain
+// the root, so that callers can round the result in the pool's favour.
+package solver
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+var (
+	ErrInvalidParams = errors.New("invalid solver params")
+	ErrInvalidBounds = errors.New("invalid solver bounds")
+	ErrNotBracketed  = errors.New("function does not change sign within the bounds")
+	ErrNotConverged  = errors.New("solver did not converge within the maximum number of iterations")
+)
+
+// Function is a continuous function of one variable.
+// It must be deterministic, e.g. only use osmomath arithmetic.
+type Function func(x osmomath.BigDec) osmomath.BigDec
+
+// Params bound the work done by a solver.
+type Params struct {
+	// MaxIterations is the maximum number of function evaluations after the bounds,
+	// after which ErrNotConverged is returned.
+	MaxIterations int
+	// Tolerance is the width of the bracket around the root at which the solver stops.
+	Tolerance osmomath.BigDec
+}
+
+// DefaultParams returns params that solve to the precision of sdk.Dec,
+// for bounds up to 10^30 apart.
+func DefaultParams() Params {
+	return Params{
+		MaxIterations: 256,
+		Tolerance:     osmomath.NewDecWithPrec(1, 18),
+	}
+}
+
+func (p Params) Validate() error {
+	if p.MaxIterations <= 0 {
+		return fmt.Errorf("%w: max iterations must be positive, got %d", ErrInvalidParams, p.MaxIterations)
+	}
+	if p.Tolerance.IsNil() || !p.Tolerance.IsPositive() {
+		return fmt.Errorf("%w: tolerance must be positive, got %s", ErrInvalidParams, p.Tolerance)
+	}
+	return nil
+}
+
+// Bracket is an interval [Lower, Upper] that contains a root.
+type Bracket struct {
+	Lower osmomath.BigDec
+	Upper osmomath.BigDec
+}
+
+// Width returns Upper - Lower.
+func (b Bracket) Width() osmomath.BigDec {
+	return b.Upper.Sub(b.Lower)
+}
+
+// Mid returns the midpoint of the bracket.
+func (b Bracket) Mid() osmomath.BigDec {
+	return b.Lower.Add(b.Upper).QuoInt64(2)
+}
+
+func exactBracket(x osmomath.BigDec) Bracket {
+	return Bracket{Lower: x, Upper: x.Clone()}
+}
+
+// sameSign returns true if a and b are both negative or both positive.
+func sameSign(a, b osmomath.BigDec) bool {
+	return a.IsNegative() == b.IsNegative()
+}
+
+// bracketer maintains a bracket around the root of f, and the sign of f at its lower bound.
+type bracketer struct {
+	f      Function
+	params Params
+
+	bracket Bracket
+	fLower  osmomath.BigDec
+}
+
+// newBracketer checks the params, and that f changes sign within [lower, upper].
+// If f is zero at either bound, the bracket is that bound, and so already converged.
+func newBracketer(f Function, lower, upper osmomath.BigDec, params Params) (*bracketer, error) {
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if lower.IsNil() || upper.IsNil() || lower.GT(upper) {
+		return nil, fmt.Errorf("%w: lower bound %s must not be greater than upper bound %s", ErrInvalidBounds, lower, upper)
+	}
+
+	b := &bracketer{f: f, params: params}
+	fLower := f(lower)
+	if fLower.IsZero() {
+		b.bracket = exactBracket(lower)
+		return b, nil
+	}
+	fUpper := f(upper)
+	if fUpper.IsZero() {
+		b.bracket = exactBracket(upper)
+		return b, nil
+	}
+	if sameSign(fLower, fUpper) {
+		return nil, fmt.Errorf("%w: f(%s) = %s, f(%s) = %s", ErrNotBracketed, lower, fLower, upper, fUpper)
+	}
+
+	b.bracket = Bracket{Lower: lower, Upper: upper}
+	b.fLower = fLower
+	return b, nil
+}
+
+// converged returns true once the bracket is no wider than the tolerance.
+func (b *bracketer) converged() bool {
+	return b.bracket.Width().LTE(b.params.Tolerance)
+}
+
+// evaluate evaluates f at x, which must be within the bracket, and shrinks the bracket to
+// the side of x that contains the root. It returns true if x is an exact root.
+func (b *bracketer) evaluate(x osmomath.BigDec) (fx osmomath.BigDec, exact bool) {
+	fx = b.f(x)
+	if fx.IsZero() {
+		b.bracket = exactBracket(x)
+		return fx, true
+	}
+	if sameSign(fx, b.fLower) {
+		b.bracket.Lower = x
+		b.fLower = fx
+	} else {
+		b.bracket.Upper = x
+	}
+	return fx, false
+}
+
+// strictlyWithin returns true if x is within the bracket and not one of its bounds.
+func (b *bracketer) strictlyWithin(x osmomath.BigDec) bool {
+	return x.GT(b.bracket.Lower) && x.LT(b.bracket.Upper)
+}
+
+func (b *bracketer) result() (Bracket, error) {
+	if !b.converged() {
+		return Bracket{}, fmt.Errorf("%w: %d iterations, bracket [%s, %s]",
+			ErrNotConverged, b.params.MaxIterations, b.bracket.Lower, b.bracket.Upper)
+	}
+	return b.bracket, nil
+}
+
+// Bisection finds a root of f within [lower, upper], by halving the bracket every iteration.
+// f must have different signs at lower and upper, or be zero at one of them.
+// It converges within log2((upper - lower) / tolerance) iterations.
+func Bisection(f Function, lower, upper osmomath.BigDec, params Params) (Bracket, error) {
+	b, err := newBracketer(f, lower, upper, params)
+	if err != nil {
+		return Bracket{}, err
+	}
+
+	for i := 0; i < params.MaxIterations && !b.converged(); i++ {
+		// the bracket is wider than the tolerance, so at least two of the smallest
+		// decimals wide, and the midpoint is strictly within it.
+		if _, exact := b.evaluate(b.bracket.Mid()); exact {
+			break
+		}
+	}
+
+	return b.result()
+}
+
+// Newton finds a root of f within [lower, upper] with Newton's method, given the derivative df
+// and an initial guess within the bounds.
+// f must have different signs at lower and upper, or be zero at one of them.
+//
+// The method is safeguarded to always converge: a bracket around the root is kept, and the
+// midpoint of the bracket is used instead of the Newton step whenever that step leaves the
+// bracket, or is not at most half the previous step.
+// When a Newton step is smaller than the tolerance, the guess is moved by the tolerance instead,
+// so that a root in between closes the bracket.
+func Newton(f, df Function, guess, lower, upper osmomath.BigDec, params Params) (Bracket, error) {
+	b, err := newBracketer(f, lower, upper, params)
+	if err != nil {
+		return Bracket{}, err
+	}
+	if guess.IsNil() || guess.LT(lower) || guess.GT(upper) {
+		return Bracket{}, fmt.Errorf("%w: guess %s must be within [%s, %s]", ErrInvalidBounds, guess, lower, upper)
+	}
+
+	x := guess
+	// the first Newton step is always taken
+	prevStep := b.bracket.Width().MulInt64(2)
+	for i := 0; i < params.MaxIterations && !b.converged(); i++ {
+		fx, exact := b.evaluate(x)
+		if exact || b.converged() {
+			break
+		}
+
+		next, ok := newtonStep(x, fx, df(x), params.Tolerance)
+		if !ok || !b.strictlyWithin(next) || next.Sub(x).Abs().MulInt64(2).GT(prevStep) {
+			next = b.bracket.Mid()
+		}
+		prevStep = next.Sub(x).Abs()
+		x = next
+	}
+
+	return b.result()
+}
+
+// newtonStep returns x - fx / dfx, moved to be at least tolerance away from x.
+// It returns false if the derivative is zero.
+func newtonStep(x, fx, dfx, tolerance osmomath.BigDec) (osmomath.BigDec, bool) {
+	if dfx.IsZero() {
+		return osmomath.BigDec{}, false
+	}
+	step := fx.Quo(dfx)
+	if step.Abs().LT(tolerance) {
+		if step.IsNegative() {
+			step = tolerance.Neg()
+		} else {
+			step = tolerance
+		}
+	}
+	return x.Sub(step), true
+}
+
+// SolveInvariant finds the value of the unknown reserve within [lower, upper] for which
+// invariant(reserve) = target, where invariant computes a pool's invariant with all other
+// reserves fixed. The invariant must be monotonic in the reserve within the bounds.
+func SolveInvariant(invariant Function, target, lower, upper osmomath.BigDec, params Params) (Bracket, error) {
+	f := func(reserve osmomath.BigDec) osmomath.BigDec {
+		return invariant(reserve).Sub(target)
+	}
+	return Bisection(f, lower, upper, params)
+}
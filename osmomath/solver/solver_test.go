@@ -0,0 +1,170 @@
+package solver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+)
+
+var (
+	one = osmomath.OneDec()
+	two = osmomath.NewBigDec(2)
+)
+
+// countEvaluations wraps f to count the number of times it is evaluated.
+func countEvaluations(f Function, count *int) Function {
+	return func(x osmomath.BigDec) osmomath.BigDec {
+		*count++
+		return f(x)
+	}
+}
+
+// requireContains checks that the bracket converged around expected.
+func requireContains(t *testing.T, bracket Bracket, expected osmomath.BigDec, params Params) {
+	require.True(t, bracket.Width().LTE(params.Tolerance), "bracket [%s, %s] wider than %s", bracket.Lower, bracket.Upper, params.Tolerance)
+	require.True(t, bracket.Lower.LTE(expected) && bracket.Upper.GTE(expected), "bracket [%s, %s] does not contain %s", bracket.Lower, bracket.Upper, expected)
+}
+
+func TestBisectionAndNewton(t *testing.T) {
+	// x^2 - 2, with its root at sqrt(2)
+	f := func(x osmomath.BigDec) osmomath.BigDec { return x.Mul(x).Sub(two) }
+	df := func(x osmomath.BigDec) osmomath.BigDec { return x.MulInt64(2) }
+	sqrt2 := osmomath.Sqrt(two)
+
+	params := DefaultParams()
+	bisectionEvaluations, newtonEvaluations := 0, 0
+
+	bracket, err := Bisection(countEvaluations(f, &bisectionEvaluations), osmomath.ZeroDec(), two, params)
+	require.NoError(t, err)
+	requireContains(t, bracket, sqrt2, params)
+
+	bracket, err = Newton(countEvaluations(f, &newtonEvaluations), df, one, osmomath.ZeroDec(), two, params)
+	require.NoError(t, err)
+	requireContains(t, bracket, sqrt2, params)
+
+	require.Less(t, newtonEvaluations, bisectionEvaluations)
+
+	// the solvers are deterministic
+	bracket2, err := Newton(f, df, one, osmomath.ZeroDec(), two, params)
+	require.NoError(t, err)
+	require.Equal(t, bracket, bracket2)
+}
+
+func TestExactRoot(t *testing.T) {
+	// x - 1, with its root at 1
+	f := func(x osmomath.BigDec) osmomath.BigDec { return x.Sub(one) }
+	df := func(x osmomath.BigDec) osmomath.BigDec { return one }
+	params := DefaultParams()
+
+	tests := []struct {
+		name         string
+		lower, upper osmomath.BigDec
+	}{
+		{"root at lower bound", one, two},
+		{"root at upper bound", osmomath.ZeroDec(), one},
+		{"root at midpoint", osmomath.ZeroDec(), two},
+	}
+
+	for _, test := range tests {
+		bracket, err := Bisection(f, test.lower, test.upper, params)
+		require.NoError(t, err, test.name)
+		require.True(t, bracket.Lower.Equal(one) && bracket.Upper.Equal(one), test.name)
+
+		bracket, err = Newton(f, df, test.lower, test.lower, test.upper, params)
+		require.NoError(t, err, test.name)
+		require.True(t, bracket.Lower.Equal(one) && bracket.Upper.Equal(one), test.name)
+	}
+}
+
+func TestSolveInvariant(t *testing.T) {
+	params := DefaultParams()
+
+	t.Run("weighted pool", func(t *testing.T) {
+		// x^wx * y^wy = k, which has the closed form y' = y * (x / x')^(wx / wy)
+		x, y := osmomath.NewBigDec(1000000), osmomath.NewBigDec(2000000)
+		wx, wy := osmomath.MustNewDecFromStr("0.2"), osmomath.MustNewDecFromStr("0.8")
+		invariant := func(x, y osmomath.BigDec) osmomath.BigDec {
+			return osmomath.LnExpPow(x, wx).Mul(osmomath.LnExpPow(y, wy))
+		}
+
+		xAfter := x.Add(osmomath.NewBigDec(12345))
+		bracket, err := SolveInvariant(func(yAfter osmomath.BigDec) osmomath.BigDec {
+			return invariant(xAfter, yAfter)
+		}, invariant(x, y), one, y, params)
+		require.NoError(t, err)
+
+		expected := y.Mul(osmomath.LnExpPow(x.Quo(xAfter), wx.Quo(wy)))
+		// the invariant itself is computed with an error of around 10^-27
+		require.True(t, bracket.Mid().Sub(expected).Abs().LTE(osmomath.NewDecWithPrec(1, 17)),
+			"expected %s, got [%s, %s]", expected, bracket.Lower, bracket.Upper)
+	})
+
+	t.Run("stableswap curve", func(t *testing.T) {
+		// x^3 * y + x * y^3 = k, which has no convenient closed form for y
+		x, y := osmomath.NewBigDec(1000000), osmomath.NewBigDec(1000000)
+		invariant := func(x, y osmomath.BigDec) osmomath.BigDec {
+			return x.Power(3).Mul(y).Add(x.Mul(y.Power(3)))
+		}
+		k := invariant(x, y)
+
+		xAfter := x.Add(osmomath.NewBigDec(10000))
+		f := func(yAfter osmomath.BigDec) osmomath.BigDec {
+			return invariant(xAfter, yAfter).Sub(k)
+		}
+		df := func(yAfter osmomath.BigDec) osmomath.BigDec {
+			return xAfter.Power(3).Add(xAfter.Mul(yAfter.Power(2)).MulInt64(3))
+		}
+
+		bisection, err := SolveInvariant(func(yAfter osmomath.BigDec) osmomath.BigDec {
+			return invariant(xAfter, yAfter)
+		}, k, one, y, params)
+		require.NoError(t, err)
+
+		newton, err := Newton(f, df, y, one, y, params)
+		require.NoError(t, err)
+
+		for _, bracket := range []Bracket{bisection, newton} {
+			require.True(t, bracket.Width().LTE(params.Tolerance))
+			require.True(t, f(bracket.Lower).IsNegative())
+			require.True(t, f(bracket.Upper).IsPositive())
+		}
+		// close to, but slightly less than, a one to one swap
+		require.True(t, y.Sub(newton.Lower).LT(osmomath.NewBigDec(10000)))
+		require.True(t, y.Sub(newton.Lower).GT(osmomath.NewBigDec(9990)))
+	})
+}
+
+func TestSolverErrors(t *testing.T) {
+	f := func(x osmomath.BigDec) osmomath.BigDec { return x.Mul(x).Sub(two) }
+	df := func(x osmomath.BigDec) osmomath.BigDec { return x.MulInt64(2) }
+	zero := osmomath.ZeroDec()
+
+	tests := []struct {
+		name         string
+		lower, upper osmomath.BigDec
+		guess        osmomath.BigDec
+		params       Params
+		expectedErr  error
+	}{
+		{"zero max iterations", zero, two, one, Params{MaxIterations: 0, Tolerance: osmomath.SmallestDec()}, ErrInvalidParams},
+		{"zero tolerance", zero, two, one, Params{MaxIterations: 10, Tolerance: zero}, ErrInvalidParams},
+		{"nil tolerance", zero, two, one, Params{MaxIterations: 10}, ErrInvalidParams},
+		{"lower greater than upper", two, zero, one, DefaultParams(), ErrInvalidBounds},
+		{"not bracketed", zero, one, one, DefaultParams(), ErrNotBracketed},
+		{"not converged", zero, two, one, Params{MaxIterations: 3, Tolerance: osmomath.SmallestDec()}, ErrNotConverged},
+	}
+
+	for _, test := range tests {
+		_, err := Bisection(f, test.lower, test.upper, test.params)
+		require.True(t, errors.Is(err, test.expectedErr), "%s: %v", test.name, err)
+
+		_, err = Newton(f, df, test.guess, test.lower, test.upper, test.params)
+		require.True(t, errors.Is(err, test.expectedErr), "%s: %v", test.name, err)
+	}
+
+	_, err := Newton(f, df, osmomath.NewBigDec(3), zero, two, DefaultParams())
+	require.True(t, errors.Is(err, ErrInvalidBounds), err)
+}
@@ -31,6 +31,12 @@ const (
 	// Will be parsed to []sdk.Coin
 	FlagMinAmountsOut = "min-amounts-out"
 
+	// Will be parsed to uint64
+	FlagPoolIdFrom = "pool-id-from"
+	FlagPoolIdTo   = "pool-id-to"
+	// Will be parsed to sdk.Int
+	FlagMinShareAmountOut = "min-share-amount-out"
+
 	// Will be parsed to uint64
 	FlagSwapRoutePoolIds = "swap-route-pool-ids"
 	// Will be parsed to []sdk.Coin
@@ -97,6 +103,18 @@ func FlagSetExitPool() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetMigrateLiquidity() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagPoolIdFrom, 0, "The id of pool to exit")
+	fs.Uint64(FlagPoolIdTo, 0, "The id of pool to join")
+	fs.String(FlagShareAmountIn, "", "Amount of Gamm tokens of the exited pool to migrate")
+	fs.StringArray(FlagMinAmountsOut, []string{""}, "Minimum amount of each denom to receive from the exited pool (specify multiple denoms with: --min-amounts-out=1uosmo --min-amounts-out=1uion)")
+	fs.String(FlagMinShareAmountOut, "", "Minimum amount of Gamm tokens of the joined pool to receive")
+
+	return fs
+}
+
 func FlagSetJoinSwapExternAmount() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -38,6 +38,7 @@ func NewTxCmd() *cobra.Command {
 		NewJoinSwapShareAmountOut(),
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
+		NewMigrateLiquidityCmd(),
 	)
 
 	return txCmd
@@ -156,6 +157,40 @@ func NewExitPoolCmd() *cobra.Command {
 	return cmd
 }
 
+func NewMigrateLiquidityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-liquidity",
+		Short: "exit a pool and join another pool with the same assets in one step",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildMigrateLiquidityMsg(clientCtx, txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetMigrateLiquidity())
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagPoolIdFrom)
+	_ = cmd.MarkFlagRequired(FlagPoolIdTo)
+	_ = cmd.MarkFlagRequired(FlagShareAmountIn)
+	_ = cmd.MarkFlagRequired(FlagMinAmountsOut)
+	_ = cmd.MarkFlagRequired(FlagMinShareAmountOut)
+
+	return cmd
+}
+
 func NewSwapExactAmountInCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "swap-exact-amount-in [token-in] [token-out-min-amount]",
@@ -514,6 +549,63 @@ func NewBuildExitPoolMsg(clientCtx client.Context, txf tx.Factory, fs *flag.Flag
 	return txf, msg, nil
 }
 
+func NewBuildMigrateLiquidityMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	poolIdFrom, err := fs.GetUint64(FlagPoolIdFrom)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	poolIdTo, err := fs.GetUint64(FlagPoolIdTo)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountInStr, err := fs.GetString(FlagShareAmountIn)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	shareAmountIn, ok := sdk.NewIntFromString(shareAmountInStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid share amount in")
+	}
+
+	minAmountsOutStrs, err := fs.GetStringArray(FlagMinAmountsOut)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	minAmountsOut := sdk.Coins{}
+	for i := 0; i < len(minAmountsOutStrs); i++ {
+		parsed, err := sdk.ParseCoinNormalized(minAmountsOutStrs[i])
+		if err != nil {
+			return txf, nil, err
+		}
+		minAmountsOut = append(minAmountsOut, parsed)
+	}
+
+	minShareAmountOutStr, err := fs.GetString(FlagMinShareAmountOut)
+	if err != nil {
+		return txf, nil, err
+	}
+
+	minShareAmountOut, ok := sdk.NewIntFromString(minShareAmountOutStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid min share amount out")
+	}
+
+	msg := &types.MsgMigrateLiquidity{
+		Sender:            clientCtx.GetFromAddress().String(),
+		PoolIdFrom:        poolIdFrom,
+		PoolIdTo:          poolIdTo,
+		ShareInAmount:     shareAmountIn,
+		TokenOutMins:      minAmountsOut,
+		ShareOutMinAmount: minShareAmountOut,
+	}
+
+	return txf, msg, nil
+}
+
 func swapAmountInRoutes(fs *flag.FlagSet) ([]types.SwapAmountInRoute, error) {
 	swapRoutePoolIds, err := fs.GetStringArray(FlagSwapRoutePoolIds)
 	if err != nil {
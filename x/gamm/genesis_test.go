@@ -44,6 +44,8 @@ func TestGammInitGenesis(t *testing.T) {
 		NextPoolNumber: 2,
 		Params: types.Params{
 			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)},
+			MinPoolAssets:   types.MinPoolAssets,
+			MaxPoolAssets:   types.DefaultMaxPoolAssets,
 		},
 	}, app.AppCodec())
 
@@ -3,6 +3,8 @@ package gamm
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
 	"github.com/osmosis-labs/osmosis/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
@@ -53,8 +55,38 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			res, err := msgServer.ExitSwapShareAmountIn(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
 
+		case *types.MsgMigrateLiquidity:
+			res, err := msgServer.MigrateLiquidity(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s message type: %T", types.ModuleName, msg)
 		}
 	}
 }
+
+// NewParamChangeProposalHandler wraps the handler of param change proposals, rejecting the changes
+// to the gamm params that are only valid one param at a time, e.g. min pool assets above max pool assets.
+// Proposals are handled in a cache context, so a rejected proposal doesn't change any param.
+func NewParamChangeProposalHandler(k keeper.Keeper, paramChangeHandler govtypes.Handler) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		if err := paramChangeHandler(ctx, content); err != nil {
+			return err
+		}
+
+		c, ok := content.(*paramproposal.ParameterChangeProposal)
+		if !ok {
+			return nil
+		}
+		for _, change := range c.Changes {
+			if change.Subspace != types.ModuleName {
+				continue
+			}
+			if err := k.GetParams(ctx).Validate(); err != nil {
+				return sdkerrors.Wrap(paramproposal.ErrSettingParameter, err.Error())
+			}
+			break
+		}
+		return nil
+	}
+}
@@ -0,0 +1,42 @@
+package gamm_test
+
+import (
+	"testing"
+
+	paramproposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	osmoapp "github.com/osmosis-labs/osmosis/app"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestParamChangeProposalHandler(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	handler := app.GovKeeper.Router().GetRoute(paramproposal.RouterKey)
+
+	// min pool assets above the default max pool assets
+	cacheCtx, _ := ctx.CacheContext()
+	err := handler(cacheCtx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinPoolAssets), `"9"`),
+	}))
+	require.Error(t, err)
+
+	// max pool assets below the default min pool assets
+	cacheCtx, _ = ctx.CacheContext()
+	err = handler(cacheCtx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinPoolAssets), `"4"`),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxPoolAssets), `"3"`),
+	}))
+	require.Error(t, err)
+
+	// min and max pool assets changed together
+	err = handler(ctx, paramproposal.NewParameterChangeProposal("title", "description", []paramproposal.ParamChange{
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMaxPoolAssets), `"16"`),
+		paramproposal.NewParamChange(types.ModuleName, string(types.KeyMinPoolAssets), `"9"`),
+	}))
+	require.NoError(t, err)
+	params := app.GAMMKeeper.GetParams(ctx)
+	require.Equal(t, uint64(9), params.MinPoolAssets)
+	require.Equal(t, uint64(16), params.MaxPoolAssets)
+}
@@ -84,6 +84,36 @@ func (suite *KeeperTestSuite) TestJoinPoolGas() {
 	suite.Assert().LessOrEqual(int(maxGas), 100000, "max gas / join pool")
 }
 
+// TestJoinPoolGasLargePool benchmarks the join gas of pools at and above the
+// default MaxPoolAssets, so raising the param keeps joins within a known bound.
+func (suite *KeeperTestSuite) TestJoinPoolGasLargePool() {
+	for _, numAssets := range []int{types.DefaultMaxPoolAssets, 12, 16} {
+		suite.SetupTest()
+		params := suite.app.GAMMKeeper.GetParams(suite.ctx)
+		params.MaxPoolAssets = uint64(numAssets)
+		suite.app.GAMMKeeper.SetParams(suite.ctx, params)
+
+		// mint the pool creation fee and the pool assets to the account
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, defaultAddr, params.PoolCreationFee)
+		suite.Require().NoError(err)
+		poolAssets := suite.fundLargePoolAssets(defaultAddr, numAssets)
+		poolId, err := suite.app.GAMMKeeper.CreateBalancerPool(suite.ctx, defaultAddr, balanacertypes.BalancerPoolParams{
+			SwapFee: sdk.NewDec(0),
+			ExitFee: sdk.NewDec(0),
+		}, poolAssets, "")
+		suite.Require().NoError(err)
+
+		poolIDFn := func(int) uint64 { return poolId }
+		minShareOutAmountFn := func(int) sdk.Int { return minShareOutAmount }
+		maxCoinsFn := func(int) sdk.Coins { return defaultCoins }
+		avgGas, maxGas := suite.measureAvgAndMaxJoinPoolGas(100, defaultAddr, poolIDFn, minShareOutAmountFn, maxCoinsFn)
+		fmt.Printf("test deets: %d asset pool, join pool average gas %d, max gas %d\n", numAssets, avgGas, maxGas)
+		// gas grows with the number of assets, as every asset balance is transferred
+		suite.Assert().LessOrEqual(int(avgGas), 20000*numAssets, "average gas / join pool")
+		suite.Assert().LessOrEqual(int(maxGas), 20000*numAssets, "max gas / join pool")
+	}
+}
+
 func (suite *KeeperTestSuite) TestRepeatedJoinPoolDistinctDenom() {
 	suite.SetupTest()
 
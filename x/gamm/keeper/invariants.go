@@ -72,8 +72,21 @@ func PoolTotalWeightInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant
 		}
 
 		for _, pool := range pools {
+			assets := pool.GetAllPoolAssets()
+			if len(assets) < types.MinPoolAssets || len(assets) > types.MaxPoolAssetsUpperBound {
+				return sdk.FormatInvariant(types.ModuleName, "pool-total-weight",
+					fmt.Sprintf("\tgamm pool id %d\n\tinvalid number of pool assets: %d\n",
+						pool.GetId(), len(assets))), true
+			}
+
 			totalWeight := sdk.ZeroInt()
-			for _, asset := range pool.GetAllPoolAssets() {
+			for i, asset := range assets {
+				// pool asset lookups binary search over the denoms, so they must be strictly sorted
+				if i > 0 && assets[i-1].Token.Denom >= asset.Token.Denom {
+					return sdk.FormatInvariant(types.ModuleName, "pool-total-weight",
+						fmt.Sprintf("\tgamm pool id %d\n\tpool assets not sorted by denom: %s, %s\n",
+							pool.GetId(), assets[i-1].Token.Denom, asset.Token.Denom)), true
+				}
 				totalWeight = totalWeight.Add(asset.Weight)
 			}
 			if !totalWeight.Equal(pool.GetTotalWeight()) {
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -77,6 +78,24 @@ func (suite *KeeperTestSuite) prepareBalancerPoolWithPoolParams(BalancerPoolPara
 	return poolId
 }
 
+// fundLargePoolAssets funds acc with numAssets distinct denoms,
+// and returns pool assets of equal weight for all of them.
+func (suite *KeeperTestSuite) fundLargePoolAssets(acc sdk.AccAddress, numAssets int) []types.PoolAsset {
+	poolAssets := make([]types.PoolAsset, 0, numAssets)
+	coins := sdk.Coins{}
+	for i := 1; i <= numAssets; i++ {
+		denom := fmt.Sprintf("token%02d", i)
+		poolAssets = append(poolAssets, types.PoolAsset{
+			Weight: sdk.NewInt(100),
+			Token:  sdk.NewCoin(denom, sdk.NewInt(5000000)),
+		})
+		coins = coins.Add(sdk.NewCoin(denom, sdk.NewInt(10000000000)))
+	}
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc, coins)
+	suite.Require().NoError(err)
+	return poolAssets
+}
+
 func (suite *KeeperTestSuite) prepareBalancerPool() uint64 {
 	poolId := suite.prepareBalancerPoolWithPoolParams(balancer.BalancerPoolParams{
 		SwapFee: sdk.NewDec(0),
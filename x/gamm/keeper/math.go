@@ -62,27 +62,58 @@ func calcSpotPriceWithSwapFee(
 // balanceYDelta = balanceY * (1 - (balanceXBefore/balanceXAfter)^(weightX/weightY))
 // balanceYDelta is positive when the balance liquidity decreases.
 // balanceYDelta is negative when the balance liquidity increases.
+// The intermediate results are computed as osmomath.BigDec, to not lose precision
+// for large pools with small swaps.
+// Every step is rounded such that balanceYDelta is rounded up if roundUp is true,
+// and down otherwise, callers pick whichever is in the pool's favour.
 func solveConstantFunctionInvariant(
 	tokenBalanceFixedBefore,
 	tokenBalanceFixedAfter,
 	tokenWeightFixed,
 	tokenBalanceUnknownBefore,
 	tokenWeightUnknown sdk.Dec,
-) sdk.Dec {
-	// weightRatio = (weightX/weightY)
-	weightRatio := tokenWeightFixed.Quo(tokenWeightUnknown)
+	roundUp bool,
+) osmomath.BigDec {
+	// balanceYDelta decreases as y^weightRatio increases, so that is rounded the other way.
+	powRoundUp := !roundUp
+
+	// y = balanceXBefore/balanceXAfter
+	// y^weightRatio increases with y
+	y := quoDirected(osmomath.BigDecFromSDKDec(tokenBalanceFixedBefore), osmomath.BigDecFromSDKDec(tokenBalanceFixedAfter), powRoundUp)
 
-	// y = balanceXBefore/balanceYAfter
-	y := tokenBalanceFixedBefore.Quo(tokenBalanceFixedAfter)
+	// weightRatio = (weightX/weightY)
+	// y^weightRatio increases with weightRatio if y >= 1, and decreases otherwise
+	weightRatioRoundUp := powRoundUp == y.GTE(osmomath.OneDec())
+	weightRatio := quoDirected(osmomath.BigDecFromSDKDec(tokenWeightFixed), osmomath.BigDecFromSDKDec(tokenWeightUnknown), weightRatioRoundUp)
 
 	// amountY = balanceY * (1 - (y ^ weightRatio))
-	foo := osmomath.Pow(y, weightRatio)
-	multiplier := sdk.OneDec().Sub(foo)
-	return tokenBalanceUnknownBefore.Mul(multiplier)
+	var foo osmomath.BigDec
+	if powRoundUp {
+		foo = osmomath.PowRoundUp(y, weightRatio)
+	} else {
+		foo = osmomath.PowRoundDown(y, weightRatio)
+	}
+	multiplier := osmomath.OneDec().Sub(foo)
+	return mulDirected(osmomath.BigDecFromSDKDec(tokenBalanceUnknownBefore), multiplier, roundUp)
+}
+
+func mulDirected(a, b osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if roundUp {
+		return a.MulRoundUp(b)
+	}
+	return a.MulRoundDown(b)
+}
+
+func quoDirected(a, b osmomath.BigDec, roundUp bool) osmomath.BigDec {
+	if roundUp {
+		return a.QuoRoundUp(b)
+	}
+	return a.QuoRoundDown(b)
 }
 
 // calcOutGivenIn calculates token to be swapped out given
 // the provided amount, fee deducted, using solveConstantFunctionInvariant
+// The result is rounded down, in the pool's favour.
 func calcOutGivenIn(
 	tokenBalanceIn,
 	tokenWeightIn,
@@ -92,14 +123,15 @@ func calcOutGivenIn(
 	swapFee sdk.Dec,
 ) sdk.Dec {
 	// deduct swapfee on the in asset
-	tokenAmountInAfterFee := tokenAmountIn.Mul(sdk.OneDec().Sub(swapFee))
+	tokenAmountInAfterFee := osmomath.BigDecFromSDKDec(tokenAmountIn).MulRoundDown(oneMinus(swapFee)).SDKDecRoundDown()
 	// delta balanceOut is positive(tokens inside the pool decreases)
-	tokenAmountOut := solveConstantFunctionInvariant(tokenBalanceIn, tokenBalanceIn.Add(tokenAmountInAfterFee), tokenWeightIn, tokenBalanceOut, tokenWeightOut)
-	return tokenAmountOut
+	tokenAmountOut := solveConstantFunctionInvariant(tokenBalanceIn, tokenBalanceIn.Add(tokenAmountInAfterFee), tokenWeightIn, tokenBalanceOut, tokenWeightOut, false)
+	return tokenAmountOut.SDKDecRoundDown()
 }
 
 // calcInGivenOut calculates token to be provided, fee added,
 // given the swapped out amount, using solveConstantFunctionInvariant
+// The result is rounded up, in the pool's favour.
 func calcInGivenOut(
 	tokenBalanceIn,
 	tokenWeightIn,
@@ -109,26 +141,35 @@ func calcInGivenOut(
 	swapFee sdk.Dec,
 ) sdk.Dec {
 	// delta balanceIn is negative(amount of tokens inside the pool increases)
-	tokenAmountIn := solveConstantFunctionInvariant(tokenBalanceOut, tokenBalanceOut.Sub(tokenAmountOut), tokenWeightOut, tokenBalanceIn, tokenWeightIn).Neg()
+	tokenAmountIn := solveConstantFunctionInvariant(tokenBalanceOut, tokenBalanceOut.Sub(tokenAmountOut), tokenWeightOut, tokenBalanceIn, tokenWeightIn, false).Neg()
 	// We deduct a swap fee on the input asset. The swap happens by following the invariant curve on the input * (1 - swap fee)
 	//  and then the swap fee is added to the pool.
 	// Thus in order to give X amount out, we solve the invariant for the invariant input. However invariant input = (1 - swapfee) * trade input.
 	// Therefore we divide by (1 - swapfee) here
-	tokenAmountInBeforeFee := tokenAmountIn.Quo(sdk.OneDec().Sub(swapFee))
-	return tokenAmountInBeforeFee
+	tokenAmountInBeforeFee := tokenAmountIn.QuoRoundUp(oneMinus(swapFee))
+	return tokenAmountInBeforeFee.SDKDecRoundUp()
 
 }
 
+// oneMinus returns 1 - x as a BigDec, this is exact.
+func oneMinus(x sdk.Dec) osmomath.BigDec {
+	return osmomath.OneDec().Sub(osmomath.BigDecFromSDKDec(x))
+}
+
+// feeRatio returns 1 - (1 - normalizedWeight) * swapFee, rounded down.
+// It is only ever multiplied with tokens leaving the pool, or divided
+// into tokens entering it, so rounding it down is in the pool's favour.
 func feeRatio(
 	normalizedWeight,
 	swapFee sdk.Dec,
-) sdk.Dec {
-	zar := (sdk.OneDec().Sub(normalizedWeight)).Mul(swapFee)
-	return sdk.OneDec().Sub(zar)
+) osmomath.BigDec {
+	zar := oneMinus(normalizedWeight).MulRoundUp(osmomath.BigDecFromSDKDec(swapFee))
+	return osmomath.OneDec().Sub(zar)
 }
 
 // calcSingleInGivenPoolOut calculates token to be provided, fee added,
 // given the swapped out shares amount, using solveConstantFunctionInvariant
+// The result is rounded up, in the pool's favour.
 func calcSingleInGivenPoolOut(
 	tokenBalanceIn,
 	normalizedTokenWeightIn,
@@ -138,13 +179,14 @@ func calcSingleInGivenPoolOut(
 ) sdk.Dec {
 	// delta balanceIn is negative(tokens inside the pool increases)
 	// pool weight is always 1
-	tokenAmountIn := solveConstantFunctionInvariant(poolSupply.Add(poolAmountOut), poolSupply, sdk.OneDec(), tokenBalanceIn, normalizedTokenWeightIn).Neg()
+	tokenAmountIn := solveConstantFunctionInvariant(poolSupply.Add(poolAmountOut), poolSupply, sdk.OneDec(), tokenBalanceIn, normalizedTokenWeightIn, false).Neg()
 	// deduct swapfee on the in asset
-	tokenAmountInBeforeFee := tokenAmountIn.Quo(feeRatio(normalizedTokenWeightIn, swapFee))
-	return tokenAmountInBeforeFee
+	tokenAmountInBeforeFee := tokenAmountIn.QuoRoundUp(feeRatio(normalizedTokenWeightIn, swapFee))
+	return tokenAmountInBeforeFee.SDKDecRoundUp()
 }
 
 // pAo
+// The result is rounded down, in the pool's favour.
 func calcPoolOutGivenSingleIn(
 	tokenBalanceIn,
 	normalizedTokenWeightIn,
@@ -153,14 +195,15 @@ func calcPoolOutGivenSingleIn(
 	swapFee sdk.Dec,
 ) sdk.Dec {
 	// deduct swapfee on the in asset
-	tokenAmountInAfterFee := tokenAmountIn.Mul(feeRatio(normalizedTokenWeightIn, swapFee))
+	tokenAmountInAfterFee := osmomath.BigDecFromSDKDec(tokenAmountIn).MulRoundDown(feeRatio(normalizedTokenWeightIn, swapFee)).SDKDecRoundDown()
 	// delta poolSupply is negative(total pool shares increases)
 	// pool weight is always 1
-	poolAmountOut := solveConstantFunctionInvariant(tokenBalanceIn.Add(tokenAmountInAfterFee), tokenBalanceIn, normalizedTokenWeightIn, poolSupply, sdk.OneDec()).Neg()
-	return poolAmountOut
+	poolAmountOut := solveConstantFunctionInvariant(tokenBalanceIn.Add(tokenAmountInAfterFee), tokenBalanceIn, normalizedTokenWeightIn, poolSupply, sdk.OneDec(), true).Neg()
+	return poolAmountOut.SDKDecRoundDown()
 }
 
 // tAo
+// The result is rounded down, in the pool's favour.
 func calcSingleOutGivenPoolIn(
 	tokenBalanceOut,
 	normalizedTokenWeightOut,
@@ -171,17 +214,18 @@ func calcSingleOutGivenPoolIn(
 ) sdk.Dec {
 	// charge exit fee on the pool token side
 	// pAiAfterExitFee = pAi*(1-exitFee)
-	poolAmountInAfterExitFee := poolAmountIn.Mul(sdk.OneDec().Sub(exitFee))
+	poolAmountInAfterExitFee := osmomath.BigDecFromSDKDec(poolAmountIn).MulRoundDown(oneMinus(exitFee)).SDKDecRoundDown()
 
 	// delta balanceOut is positive(tokens inside the pool decreases)
 	// pool weight is always 1
-	tokenAmountOut := solveConstantFunctionInvariant(poolSupply.Sub(poolAmountInAfterExitFee), poolSupply, sdk.OneDec(), tokenBalanceOut, normalizedTokenWeightOut)
+	tokenAmountOut := solveConstantFunctionInvariant(poolSupply.Sub(poolAmountInAfterExitFee), poolSupply, sdk.OneDec(), tokenBalanceOut, normalizedTokenWeightOut, false)
 	// deduct
-	tokenAmountOutAfterFee := tokenAmountOut.Mul(feeRatio(normalizedTokenWeightOut, swapFee))
-	return tokenAmountOutAfterFee
+	tokenAmountOutAfterFee := tokenAmountOut.MulRoundDown(feeRatio(normalizedTokenWeightOut, swapFee))
+	return tokenAmountOutAfterFee.SDKDecRoundDown()
 }
 
 // pAi
+// The result is rounded up, in the pool's favour.
 func calcPoolInGivenSingleOut(
 	tokenBalanceOut,
 	normalizedTokenWeightOut,
@@ -190,14 +234,14 @@ func calcPoolInGivenSingleOut(
 	swapFee sdk.Dec,
 	exitFee sdk.Dec,
 ) sdk.Dec {
-	tokenAmountOutBeforeFee := tokenAmountOut.Quo(feeRatio(normalizedTokenWeightOut, swapFee))
+	tokenAmountOutBeforeFee := osmomath.BigDecFromSDKDec(tokenAmountOut).QuoRoundUp(feeRatio(normalizedTokenWeightOut, swapFee)).SDKDecRoundUp()
 
 	// delta poolSupply is positive(total pool shares decreases)
 	// pool weight is always 1
-	poolAmountIn := solveConstantFunctionInvariant(tokenBalanceOut.Sub(tokenAmountOutBeforeFee), tokenBalanceOut, normalizedTokenWeightOut, poolSupply, sdk.OneDec())
+	poolAmountIn := solveConstantFunctionInvariant(tokenBalanceOut.Sub(tokenAmountOutBeforeFee), tokenBalanceOut, normalizedTokenWeightOut, poolSupply, sdk.OneDec(), true)
 
 	// charge exit fee on the pool token side
 	// pAi = pAiAfterExitFee/(1-exitFee)
-	poolAmountInBeforeFee := poolAmountIn.Quo(sdk.OneDec().Sub(exitFee))
-	return poolAmountInBeforeFee
+	poolAmountInBeforeFee := poolAmountIn.QuoRoundUp(oneMinus(exitFee))
+	return poolAmountInBeforeFee.SDKDecRoundUp()
 }
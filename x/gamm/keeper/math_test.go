@@ -6,6 +6,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+
 	"github.com/stretchr/testify/require"
 )
 
@@ -62,6 +64,56 @@ func TestCalcOutGivenIn(t *testing.T) {
 
 }
 
+// A tiny swap in a large pool, where computing with sdk.Dec intermediates
+// would round the result to exactly 1.
+func TestCalcOutGivenInLargePool(t *testing.T) {
+	tc := tc(t, "1000000000000", "1", "1000000000000", "1", "", "0", "0", "0")
+
+	s := tc.calcOutGivenIn(sdk.OneDec())
+
+	// B / (B + 1) for B = 10^12
+	expectedDec, err := sdk.NewDecFromStr("0.999999999999000000")
+	require.NoError(t, err)
+	require.Equal(t, expectedDec, s)
+}
+
+// TestCalcRoundsInPoolsFavour checks that the amounts leaving the pool are never
+// more, and the amounts entering the pool never less, than the exact values.
+// The exact values are computed with Python's decimal module at 100 digits of precision.
+func TestCalcRoundsInPoolsFavour(t *testing.T) {
+	swap := tc(t, "100", "0.1", "200", "0.3", "", "0", "0.01", "0")
+	pool := tc(t, "100", "0.2", "100", "0.2", "1", "300", "0.15", "0.01")
+	ten := sdk.NewDec(10)
+	tolerance := sdk.NewDecWithPrec(1, 15)
+
+	tests := []struct {
+		name     string
+		actual   sdk.Dec
+		exact    string
+		poolPays bool
+	}{
+		{"calcOutGivenIn", swap.calcOutGivenIn(ten), "6.195392295145692278651144840821741286", true},
+		{"calcInGivenOut", swap.calcInGivenOut(sdk.NewDec(70)), "266.800917688491652988694408812752008018", false},
+		{"calcPoolOutGivenSingleIn", pool.calcPoolOutGivenSingleIn(sdk.NewDec(40)), "18.651959200072683614776545998028299149", true},
+		{"calcSingleInGivenPoolOut", pool.calcSingleInGivenPoolOut(ten), "20.244813879536101758323980546202768424", false},
+		{"calcSingleOutGivenPoolIn", pool.calcSingleOutGivenPoolIn(ten), "13.592786198674584", true},
+		{"calcPoolInGivenSingleOut", pool.calcPoolInGivenSingleOut(ten), "7.223303528142021275491801429733060577", false},
+	}
+
+	for _, test := range tests {
+		exact := osmomath.MustNewDecFromStr(test.exact)
+		var expected sdk.Dec
+		if test.poolPays {
+			expected = exact.SDKDecRoundDown()
+			require.True(t, test.actual.LTE(expected), "%s: %s > %s", test.name, test.actual, expected)
+		} else {
+			expected = exact.SDKDecRoundUp()
+			require.True(t, test.actual.GTE(expected), "%s: %s < %s", test.name, test.actual, expected)
+		}
+		require.True(t, test.actual.Sub(expected).Abs().LTE(tolerance), "%s: expected %s, got %s", test.name, expected, test.actual)
+	}
+}
+
 func TestCalcInGivenOut(t *testing.T) {
 	tc := tc(t, "100", "0.1", "200", "0.3", "", "0", "0.01", "0")
 	tokenAmountOut, err := sdk.NewDecFromStr("70")
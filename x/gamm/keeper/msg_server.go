@@ -263,3 +263,35 @@ func (server msgServer) ExitSwapShareAmountIn(goCtx context.Context, msg *types.
 
 	return &types.MsgExitSwapShareAmountInResponse{}, nil
 }
+
+func (server msgServer) MigrateLiquidity(goCtx context.Context, msg *types.MsgMigrateLiquidity) (*types.MsgMigrateLiquidityResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	shareOutAmount, err := server.keeper.MigrateLiquidity(ctx, sender, msg.PoolIdFrom, msg.PoolIdTo, msg.ShareInAmount, msg.TokenOutMins, msg.ShareOutMinAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolExited,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolIdFrom, 10)),
+		),
+		sdk.NewEvent(
+			types.TypeEvtPoolJoined,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolIdTo, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateLiquidityResponse{ShareOutAmount: shareOutAmount}, nil
+}
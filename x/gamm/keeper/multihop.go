@@ -87,7 +87,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(ctx sdk.Context, routes []types.S
 			outAsset.Weight.ToDec(),
 			tokenOut.Amount.ToDec(),
 			pool.GetPoolSwapFee(),
-		).TruncateInt()
+		).Ceil().TruncateInt()
 
 		insExpected[i] = tokenInAmount
 
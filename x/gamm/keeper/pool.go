@@ -77,6 +77,9 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.PoolI) error {
 	poolKey := types.GetKeyPrefixPools(pool.GetId())
 	store.Set(poolKey, bz)
 
+	// the time weighted price accumulators of the pool are updated at the end of the block
+	k.markPoolChanged(ctx, pool.GetId())
+
 	return nil
 }
 
@@ -88,6 +91,8 @@ func (k Keeper) DeletePool(ctx sdk.Context, poolId uint64) error {
 	}
 
 	store.Delete(poolKey)
+	ctx.TransientStore(k.transientKey).Delete(types.GetKeyChangedPool(poolId))
+	k.deleteTwapRecords(ctx, poolId)
 	return nil
 }
 
@@ -19,19 +19,21 @@ func (k Keeper) CreateBalancerPool(
 	poolAssets []types.PoolAsset,
 	futurePoolGovernor string,
 ) (uint64, error) {
-	if len(poolAssets) < types.MinPoolAssets {
-		return 0, types.ErrTooFewPoolAssets
+	params := k.GetParams(ctx)
+	if uint64(len(poolAssets)) < params.MinPoolAssets {
+		return 0, sdkerrors.Wrapf(
+			types.ErrTooFewPoolAssets,
+			"pool has too few PoolAssets (%d), minimum is %d", len(poolAssets), params.MinPoolAssets,
+		)
 	}
-	// TODO: Add the limit of binding token to the pool params?
-	if len(poolAssets) > types.MaxPoolAssets {
+	if uint64(len(poolAssets)) > params.MaxPoolAssets {
 		return 0, sdkerrors.Wrapf(
 			types.ErrTooManyPoolAssets,
-			"pool has too many PoolAssets (%d)", len(poolAssets),
+			"pool has too many PoolAssets (%d), maximum is %d", len(poolAssets), params.MaxPoolAssets,
 		)
 	}
 
 	// send pool creation fee to community pool
-	params := k.GetParams(ctx)
 	err := k.distrKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender)
 	if err != nil {
 		return 0, err
@@ -129,7 +131,8 @@ func (k Keeper) JoinPool(
 	// Transfer the PoolAssets tokens to the pool's module account from the user account.
 	var coins sdk.Coins
 	for _, PoolAsset := range PoolAssets {
-		tokenInAmount := shareRatio.MulInt(PoolAsset.Token.Amount).TruncateInt()
+		// round up the tokens in, so that joining the pool never takes value from it
+		tokenInAmount := shareOutAmount.ToDec().MulInt(PoolAsset.Token.Amount).QuoRoundUp(totalSharesAmount.ToDec()).Ceil().TruncateInt()
 		if tokenInAmount.LTE(sdk.ZeroInt()) {
 			return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 		}
@@ -266,7 +269,7 @@ func (k Keeper) JoinSwapShareAmountOut(
 		pool.GetTotalShares().Amount.ToDec(),
 		shareOutAmount.ToDec(),
 		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	).Ceil().TruncateInt()
 
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
@@ -312,9 +315,21 @@ func (k Keeper) ExitPool(
 	shareInAmount sdk.Int,
 	tokenOutMins sdk.Coins,
 ) (err error) {
+	_, err = k.exitPool(ctx, sender, poolId, shareInAmount, tokenOutMins)
+	return err
+}
+
+// exitPool is ExitPool, additionally returning the coins sent to the sender.
+func (k Keeper) exitPool(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolId uint64,
+	shareInAmount sdk.Int,
+	tokenOutMins sdk.Coins,
+) (exitCoins sdk.Coins, err error) {
 	pool, err := k.GetPool(ctx, poolId)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	totalSharesAmount := pool.GetTotalShares().Amount
@@ -323,7 +338,7 @@ func (k Keeper) ExitPool(
 	shareRatio := shareInAmountAfterExitFee.ToDec().QuoInt(totalSharesAmount)
 
 	if shareRatio.LTE(sdk.ZeroDec()) {
-		return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
+		return nil, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "share ratio is zero or negative")
 	}
 
 	// Assume that the tokenInMaxAmounts is validated.
@@ -339,13 +354,13 @@ func (k Keeper) ExitPool(
 	for _, PoolAsset := range PoolAssets {
 		tokenOutAmount := shareRatio.MulInt(PoolAsset.Token.Amount).TruncateInt()
 		if tokenOutAmount.LTE(sdk.ZeroInt()) {
-			return sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
+			return nil, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 		}
 
 		// Check if a minimum token amount is specified for this token,
 		// and if so ensure that the minimum is less than the amount returned.
 		if tokenOutMinAmount, ok := tokenOutMinMap[PoolAsset.Token.Denom]; ok && tokenOutAmount.LT(tokenOutMinAmount) {
-			return sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", PoolAsset.Token.Denom)
+			return nil, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s token is lesser than min amount", PoolAsset.Token.Denom)
 		}
 
 		newPoolCoins = append(newPoolCoins,
@@ -355,12 +370,12 @@ func (k Keeper) ExitPool(
 
 	err = pool.UpdatePoolAssetBalances(newPoolCoins)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, coins)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Remove the exit fee shares from the pool.
@@ -368,25 +383,25 @@ func (k Keeper) ExitPool(
 	if exitFee.IsPositive() {
 		err = k.BurnPoolShareFromAccount(ctx, pool, sender, exitFee)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	err = k.BurnPoolShareFromAccount(ctx, pool, sender, shareInAmountAfterExitFee)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	err = k.SetPool(ctx, pool)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	k.createRemoveLiquidityEvent(ctx, sender, pool.GetId(), coins)
 	k.hooks.AfterExitPool(ctx, sender, pool.GetId(), shareInAmount, coins)
 	k.RecordTotalLiquidityDecrease(ctx, coins)
 
-	return nil
+	return coins, nil
 }
 
 func (k Keeper) ExitSwapShareAmountIn(
@@ -504,7 +519,7 @@ func (k Keeper) ExitSwapExternAmountOut(
 		tokenOut.Amount.ToDec(),
 		pool.GetPoolSwapFee(),
 		pool.GetPoolExitFee(),
-	).TruncateInt()
+	).Ceil().TruncateInt()
 
 	if shareInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
@@ -560,6 +575,76 @@ func (k Keeper) ExitSwapExternAmountOut(
 	return shareInAmount, nil
 }
 
+// MigrateLiquidity exits shareInAmount shares of poolIdFrom, and joins poolIdTo with the exited tokens,
+// in one step. Both pools must contain the same set of assets.
+// The most shares that the exited tokens can buy are minted, and any leftover tokens stay with the sender.
+func (k Keeper) MigrateLiquidity(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolIdFrom uint64,
+	poolIdTo uint64,
+	shareInAmount sdk.Int,
+	tokenOutMins sdk.Coins,
+	shareOutMinAmount sdk.Int,
+) (shareOutAmount sdk.Int, err error) {
+	if poolIdFrom == poolIdTo {
+		return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "can not migrate liquidity from pool %d to itself", poolIdFrom)
+	}
+
+	poolFrom, err := k.GetPool(ctx, poolIdFrom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	poolTo, err := k.GetPool(ctx, poolIdTo)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if !sameDenoms(poolFrom.GetAllPoolAssets(), poolTo.GetAllPoolAssets()) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolAssetsMismatch, "pool %d and pool %d", poolIdFrom, poolIdTo)
+	}
+
+	exitCoins, err := k.exitPool(ctx, sender, poolIdFrom, shareInAmount, tokenOutMins)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	// The shares the exited tokens can buy are limited by the asset
+	// with the smallest exited amount relative to its balance in the new pool.
+	totalSharesAmount := poolTo.GetTotalShares().Amount
+	for _, PoolAsset := range poolTo.GetAllPoolAssets() {
+		shares := exitCoins.AmountOf(PoolAsset.Token.Denom).Mul(totalSharesAmount).Quo(PoolAsset.Token.Amount)
+		if shareOutAmount.IsNil() || shares.LT(shareOutAmount) {
+			shareOutAmount = shares
+		}
+	}
+
+	if shareOutAmount.LT(shareOutMinAmount) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMinAmount, "%s share amount is lesser than min amount", shareOutAmount)
+	}
+
+	err = k.JoinPool(ctx, sender, poolIdTo, shareOutAmount, exitCoins)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return shareOutAmount, nil
+}
+
+func sameDenoms(a, b []types.PoolAsset) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	// Pool assets are always stored sorted by denom.
+	for i := range a {
+		if a[i].Token.Denom != b[i].Token.Denom {
+			return false
+		}
+	}
+	return true
+}
+
 func (k Keeper) GetTotalLiquidity(ctx sdk.Context) sdk.Coins {
 	coins := sdk.Coins{}
 	k.IterateDenomLiquidity(ctx, func(coin sdk.Coin) bool {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	gammkeeper "github.com/osmosis-labs/osmosis/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/x/gamm/types"
 )
@@ -195,9 +196,9 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
-			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: sdk.Coins{},
-			})
+			params := types.DefaultParams()
+			params.PoolCreationFee = sdk.Coins{}
+			keeper.SetParams(suite.ctx, params)
 			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, balancer.BalancerPoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -210,9 +211,9 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 	}, {
 		fn: func() {
 			keeper := suite.app.GAMMKeeper
-			keeper.SetParams(suite.ctx, types.Params{
-				PoolCreationFee: nil,
-			})
+			params := types.DefaultParams()
+			params.PoolCreationFee = nil
+			keeper.SetParams(suite.ctx, params)
 			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, balancer.BalancerPoolParams{
 				SwapFee: sdk.NewDecWithPrec(1, 2),
 				ExitFee: sdk.NewDecWithPrec(1, 2),
@@ -222,6 +223,38 @@ func (suite *KeeperTestSuite) TestCreateBalancerPool() {
 			suite.Require().Len(pools, 1)
 			suite.Require().NoError(err)
 		},
+	}, {
+		fn: func() {
+			keeper := suite.app.GAMMKeeper
+			params := types.DefaultParams()
+			params.MinPoolAssets = 3
+			keeper.SetParams(suite.ctx, params)
+			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, defaultBalancerPoolParams, defaultPoolAssets, defaultFutureGovernor)
+			suite.Require().ErrorIs(err, types.ErrTooFewPoolAssets, "can't create a pool with fewer assets than the MinPoolAssets param")
+		},
+	}, {
+		fn: func() {
+			keeper := suite.app.GAMMKeeper
+			poolAssets := suite.fundLargePoolAssets(acc1, types.DefaultMaxPoolAssets+1)
+			_, err := keeper.CreateBalancerPool(suite.ctx, acc1, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor)
+			suite.Require().ErrorIs(err, types.ErrTooManyPoolAssets, "can't create a pool with more assets than the MaxPoolAssets param")
+		},
+	}, {
+		fn: func() {
+			keeper := suite.app.GAMMKeeper
+			params := types.DefaultParams()
+			params.MaxPoolAssets = 16
+			keeper.SetParams(suite.ctx, params)
+			poolAssets := suite.fundLargePoolAssets(acc1, 16)
+			poolId, err := keeper.CreateBalancerPool(suite.ctx, acc1, defaultBalancerPoolParams, poolAssets, defaultFutureGovernor)
+			suite.Require().NoError(err)
+			pool, err := keeper.GetPool(suite.ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(16, pool.NumAssets())
+
+			_, broken := gammkeeper.PoolTotalWeightInvariant(*keeper, suite.app.BankKeeper)(suite.ctx)
+			suite.Require().False(broken)
+		},
 	}}
 
 	for _, test := range tests {
@@ -325,6 +358,40 @@ func (suite *KeeperTestSuite) TestJoinPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestJoinPoolRoundsInPoolFavor() {
+	suite.SetupTest()
+	poolId := suite.prepareBalancerPool()
+
+	for _, shareOutAmount := range []sdk.Int{
+		sdk.NewInt(100),
+		sdk.NewInt(33333333333333333),
+		types.OneShare.AddRaw(7),
+		types.OneShare.MulRaw(3).QuoRaw(7),
+	} {
+		poolBefore, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+		suite.Require().NoError(err)
+
+		err = suite.app.GAMMKeeper.JoinPool(suite.ctx, acc2, poolId, shareOutAmount, sdk.Coins{})
+		suite.Require().NoError(err)
+
+		poolAfter, err := suite.app.GAMMKeeper.GetPool(suite.ctx, poolId)
+		suite.Require().NoError(err)
+
+		// the pool tokens per share never decrease
+		sharesBefore := poolBefore.GetTotalShares().Amount
+		sharesAfter := poolAfter.GetTotalShares().Amount
+		suite.Require().Equal(sharesBefore.Add(shareOutAmount), sharesAfter)
+		for _, assetBefore := range poolBefore.GetAllPoolAssets() {
+			assetAfter, err := poolAfter.GetPoolAsset(assetBefore.Token.Denom)
+			suite.Require().NoError(err)
+			suite.Require().True(
+				assetAfter.Token.Amount.Mul(sharesBefore).GTE(assetBefore.Token.Amount.Mul(sharesAfter)),
+				"join of %s shares took %s from the pool", shareOutAmount, assetBefore.Token.Denom,
+			)
+		}
+	}
+}
+
 func (suite *KeeperTestSuite) TestExitPool() {
 	tests := []struct {
 		fn func(poolId uint64)
@@ -419,6 +486,105 @@ func (suite *KeeperTestSuite) TestExitPool() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigrateLiquidity() {
+	tests := []struct {
+		name              string
+		poolToAssets      []types.PoolAsset
+		shareInAmount     sdk.Int
+		tokenOutMins      sdk.Coins
+		shareOutMinAmount sdk.Int
+		expectPass        bool
+	}{
+		{
+			// Exiting half of pool 1 returns 5000foo and 5000bar. Pool 2 holds twice as much foo,
+			// so only 25 shares can be bought, using 5000foo and 2500bar.
+			name: "migrate to a pool with a different ratio",
+			poolToAssets: []types.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(20000))},
+				defaultBarAsset,
+			},
+			shareInAmount:     types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: types.OneShare.MulRaw(25),
+			expectPass:        true,
+		},
+		{
+			name:              "token out mins not met",
+			poolToAssets:      defaultPoolAssets,
+			shareInAmount:     types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(5001))),
+			shareOutMinAmount: sdk.OneInt(),
+			expectPass:        false,
+		},
+		{
+			name: "share out min not met",
+			poolToAssets: []types.PoolAsset{
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("foo", sdk.NewInt(20000))},
+				defaultBarAsset,
+			},
+			shareInAmount:     types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: types.OneShare.MulRaw(25).AddRaw(1),
+			expectPass:        false,
+		},
+		{
+			name: "pools with different assets",
+			poolToAssets: []types.PoolAsset{
+				defaultFooAsset,
+				{Weight: sdk.NewInt(100), Token: sdk.NewCoin("baz", sdk.NewInt(10000))},
+			},
+			shareInAmount:     types.OneShare.MulRaw(50),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.OneInt(),
+			expectPass:        false,
+		},
+		{
+			name:              "more shares than owned",
+			poolToAssets:      defaultPoolAssets,
+			shareInAmount:     types.InitPoolSharesSupply.AddRaw(1),
+			tokenOutMins:      sdk.Coins{},
+			shareOutMinAmount: sdk.OneInt(),
+			expectPass:        false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.SetupTest()
+
+		err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, acc1, defaultAcctFunds)
+		suite.Require().NoError(err)
+
+		keeper := suite.app.GAMMKeeper
+		poolIdFrom, err := keeper.CreateBalancerPool(suite.ctx, acc1, balancer.BalancerPoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 2),
+			ExitFee: sdk.NewDec(0),
+		}, defaultPoolAssets, defaultFutureGovernor)
+		suite.Require().NoError(err)
+		poolIdTo, err := keeper.CreateBalancerPool(suite.ctx, acc1, balancer.BalancerPoolParams{
+			SwapFee: sdk.NewDecWithPrec(1, 3),
+			ExitFee: sdk.NewDec(0),
+		}, test.poolToAssets, defaultFutureGovernor)
+		suite.Require().NoError(err)
+
+		balancesBefore := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+		shareOutAmount, err := keeper.MigrateLiquidity(suite.ctx, acc1, poolIdFrom, poolIdTo, test.shareInAmount, test.tokenOutMins, test.shareOutMinAmount)
+		balancesAfter := suite.app.BankKeeper.GetAllBalances(suite.ctx, acc1)
+
+		if !test.expectPass {
+			suite.Require().Error(err, "test: %v", test.name)
+			continue
+		}
+		suite.Require().NoError(err, "test: %v", test.name)
+		suite.Require().Equal(types.OneShare.MulRaw(25).String(), shareOutAmount.String())
+
+		suite.Require().Equal(test.shareInAmount, balancesBefore.AmountOf("gamm/pool/1").Sub(balancesAfter.AmountOf("gamm/pool/1")))
+		suite.Require().Equal(shareOutAmount, balancesAfter.AmountOf("gamm/pool/2").Sub(balancesBefore.AmountOf("gamm/pool/2")))
+		// The 2500bar that did not fit into the new pool's ratio stays with the sender.
+		suite.Require().Equal(balancesBefore.AmountOf("foo"), balancesAfter.AmountOf("foo"))
+		suite.Require().Equal(balancesBefore.AmountOf("bar").AddRaw(2500), balancesAfter.AmountOf("bar"))
+	}
+}
+
 func (suite *KeeperTestSuite) TestActiveBalancerPool() {
 	type testCase struct {
 		blockTime  time.Time
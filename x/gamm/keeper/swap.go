@@ -98,7 +98,7 @@ func (k Keeper) SwapExactAmountOut(
 		outPoolAsset.Weight.ToDec(),
 		tokenOut.Amount.ToDec(),
 		pool.GetPoolSwapFee(),
-	).TruncateInt()
+	).Ceil().TruncateInt()
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
 		return sdk.Int{}, sdk.Dec{}, sdkerrors.Wrapf(types.ErrInvalidMathApprox, "token amount is zero or negative")
 	}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+// maxLnTwap bounds the logarithm of time weighted prices, so that the price fits into an sdk.Dec.
+var maxLnTwap = sdk.NewDec(120)
+
+// markPoolChanged tracks the pool as changed in the current block, in the transient store.
+func (k Keeper) markPoolChanged(ctx sdk.Context, poolId uint64) {
+	ctx.TransientStore(k.transientKey).Set(types.GetKeyChangedPool(poolId), []byte{})
+}
+
+// RecordChangedPoolsTwap updates the time weighted price accumulators of the pools changed in the
+// current block. It is called at the end of the block, so the records hold the state of the pools
+// at the end of the block, and their cost is not charged to the transactions changing the pools.
+func (k Keeper) RecordChangedPoolsTwap(ctx sdk.Context) {
+	iter := sdk.KVStorePrefixIterator(ctx.TransientStore(k.transientKey), types.KeyPrefixChangedPools)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		poolId := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixChangedPools):])
+		pool, err := k.GetPool(ctx, poolId)
+		if err == nil {
+			err = k.recordPoolTwap(ctx, pool)
+		}
+		if err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("failed to record the time weighted price of pool %d: %s", poolId, err))
+		}
+	}
+}
+
+// recordPoolTwap updates the time weighted price accumulators of the pool at the current block time.
+// There is a single record per pool per block, an update in the same block overwrites it.
+// Records older than types.TwapRecordRetention are pruned, except the one in effect at the cutoff.
+func (k Keeper) recordPoolTwap(ctx sdk.Context, pool types.PoolI) error {
+	blockTime := ctx.BlockTime()
+
+	record := types.TwapRecord{}
+	prevTime, prevRecord, found, err := k.getTwapRecordAt(ctx, pool.GetId(), blockTime)
+	if err != nil {
+		return err
+	}
+	for _, asset := range pool.GetAllPoolAssets() {
+		lnNormalizedBalance, err := lnNormalizedBalance(asset)
+		if err != nil {
+			return err
+		}
+		// assets without an accumulator were not in the pool before, and start from zero
+		accumulator := sdk.ZeroDec()
+		if found {
+			if prevAccumulator, err := accumulatorAt(prevRecord, prevTime, blockTime, asset.Token.Denom); err == nil {
+				accumulator = prevAccumulator
+			}
+		}
+		record.Accumulators = append(record.Accumulators, types.TwapAccumulator{
+			Denom:               asset.Token.Denom,
+			LnNormalizedBalance: lnNormalizedBalance,
+			Accumulator:         accumulator,
+		})
+	}
+
+	bz, err := k.cdc.Marshal(&record)
+	if err != nil {
+		return err
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyTwapRecord(pool.GetId(), blockTime), bz)
+
+	if found && prevTime.Before(blockTime) {
+		k.pruneTwapRecords(ctx, pool.GetId(), blockTime.Add(-types.TwapRecordRetention))
+	}
+	return nil
+}
+
+// lnNormalizedBalance returns ln(balance / weight) of the pool asset.
+func lnNormalizedBalance(asset types.PoolAsset) (sdk.Dec, error) {
+	if !asset.Token.Amount.IsPositive() || !asset.Weight.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("pool asset %s must have a positive balance and weight", asset.Token.Denom)
+	}
+	normalizedBalance := osmomath.NewDecFromInt(asset.Token.Amount).QuoInt(asset.Weight)
+	return osmomath.Ln(normalizedBalance).SDKDecRounded(), nil
+}
+
+// accumulatorAt returns the accumulator of the denom extended from the record time to t,
+// during which the state at the end of the block of the record was in effect.
+func accumulatorAt(record types.TwapRecord, recordTime, t time.Time, denom string) (sdk.Dec, error) {
+	accumulator, err := getTwapAccumulator(record, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	elapsed := t.Sub(recordTime).Nanoseconds()
+	return accumulator.Accumulator.Add(accumulator.LnNormalizedBalance.MulInt64(elapsed)), nil
+}
+
+func getTwapAccumulator(record types.TwapRecord, denom string) (types.TwapAccumulator, error) {
+	for _, accumulator := range record.Accumulators {
+		if accumulator.Denom == denom {
+			return accumulator, nil
+		}
+	}
+	return types.TwapAccumulator{}, fmt.Errorf("can't find the price accumulator of %s", denom)
+}
+
+// pruneTwapRecords deletes the records of the pool from before the cutoff,
+// except the latest of them, which is in effect at the cutoff.
+func (k Keeper) pruneTwapRecords(ctx sdk.Context, poolId uint64, cutoff time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(
+		types.GetKeyPrefixTwapRecords(poolId),
+		types.GetKeyTwapRecord(poolId, cutoff))
+
+	keys := [][]byte{}
+	for first := true; iter.Valid(); iter.Next() {
+		if first {
+			first = false
+			continue
+		}
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// deleteTwapRecords deletes all records of the pool.
+func (k Keeper) deleteTwapRecords(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GetKeyPrefixTwapRecords(poolId))
+
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// getTwapRecordAt returns the latest record of the pool at or before t, along with its time.
+func (k Keeper) getTwapRecordAt(ctx sdk.Context, poolId uint64, t time.Time) (time.Time, types.TwapRecord, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixTwapRecords(poolId)
+	// keys are exclusive at the end, so move the time by a nanosecond to include it
+	iter := store.ReverseIterator(prefix, types.GetKeyTwapRecord(poolId, t.Add(time.Nanosecond)))
+	defer iter.Close()
+	if !iter.Valid() {
+		return time.Time{}, types.TwapRecord{}, false, nil
+	}
+	return k.unmarshalTwapRecord(prefix, iter.Key(), iter.Value())
+}
+
+// getFirstTwapRecord returns the earliest record of the pool, along with its time.
+func (k Keeper) getFirstTwapRecord(ctx sdk.Context, poolId uint64) (time.Time, types.TwapRecord, bool, error) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.GetKeyPrefixTwapRecords(poolId)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	if !iter.Valid() {
+		return time.Time{}, types.TwapRecord{}, false, nil
+	}
+	return k.unmarshalTwapRecord(prefix, iter.Key(), iter.Value())
+}
+
+func (k Keeper) unmarshalTwapRecord(prefix, key, value []byte) (time.Time, types.TwapRecord, bool, error) {
+	recordTime, err := sdk.ParseTimeBytes(key[len(prefix):])
+	if err != nil {
+		return time.Time{}, types.TwapRecord{}, false, err
+	}
+	record := types.TwapRecord{}
+	if err := k.cdc.Unmarshal(value, &record); err != nil {
+		return time.Time{}, types.TwapRecord{}, false, err
+	}
+	return recordTime, record, true, nil
+}
+
+// CalculateTimeWeightedSpotPrice returns the spot price of tokenInDenom in tokenOutDenom, without swap fee,
+// averaged over the window ending at the current block time. The average is the geometric mean of the
+// prices, weighted by how long each was in effect, and is computed from the accumulators at the start
+// and the end of the window only. The window is bounded by types.TwapRecordRetention, and by the creation of the pool.
+//
+// Only the state of the pool at the end of each block is recorded, and the state at the current block
+// time has no weight, so the price can not be moved within a single block. The token weights are those
+// of the recorded states, changes of the weights in between are not accounted for.
+// Returns types.ErrNoPriceHistory if the pool has no records.
+func (k Keeper) CalculateTimeWeightedSpotPrice(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, window time.Duration) (sdk.Dec, error) {
+	if window <= 0 {
+		return sdk.Dec{}, fmt.Errorf("time weighted price window must be positive, got %s", window)
+	}
+
+	end := ctx.BlockTime()
+	endTime, endRecord, found, err := k.getTwapRecordAt(ctx, poolId, end)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !found {
+		return sdk.Dec{}, sdkerrors.Wrapf(types.ErrNoPriceHistory, "pool %d", poolId)
+	}
+
+	start := end.Add(-window)
+	startTime, startRecord, found, err := k.getTwapRecordAt(ctx, poolId, start)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !found {
+		// the window starts before the earliest record, so it only covers the time since
+		startTime, startRecord, _, err = k.getFirstTwapRecord(ctx, poolId)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		start = startTime
+	}
+
+	// all records are at the current block time, e.g. for a pool created in this block,
+	// so there is only the spot price of the latest record
+	if !end.After(start) {
+		in, err := getTwapAccumulator(endRecord, tokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		out, err := getTwapAccumulator(endRecord, tokenOutDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return expLnPrice(in.LnNormalizedBalance.Sub(out.LnNormalizedBalance), tokenInDenom, tokenOutDenom)
+	}
+
+	// the accumulators sum ln(balance / weight) over time, so the difference of those of
+	// tokenInDenom and tokenOutDenom over the window, divided by its duration, is the time
+	// weighted mean of ln((balance_in / weight_in) / (balance_out / weight_out)).
+	lnPriceSum := sdk.ZeroDec()
+	for _, term := range []struct {
+		denom string
+		sign  int64
+	}{{tokenInDenom, 1}, {tokenOutDenom, -1}} {
+		endAccumulator, err := accumulatorAt(endRecord, endTime, end, term.denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		startAccumulator, err := accumulatorAt(startRecord, startTime, start, term.denom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		lnPriceSum = lnPriceSum.Add(endAccumulator.Sub(startAccumulator).MulInt64(term.sign))
+	}
+	return expLnPrice(lnPriceSum.QuoInt64(end.Sub(start).Nanoseconds()), tokenInDenom, tokenOutDenom)
+}
+
+// expLnPrice returns the price from its logarithm.
+func expLnPrice(lnPrice sdk.Dec, tokenInDenom, tokenOutDenom string) (sdk.Dec, error) {
+	if lnPrice.Abs().GT(maxLnTwap) {
+		return sdk.Dec{}, fmt.Errorf("time weighted price of %s in %s is out of range", tokenInDenom, tokenOutDenom)
+	}
+	return osmomath.Exp(osmomath.BigDecFromSDKDec(lnPrice)).SDKDecRounded(), nil
+}
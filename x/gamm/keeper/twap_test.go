@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+)
+
+func (suite *KeeperTestSuite) TestCalculateTimeWeightedSpotPrice() {
+	suite.SetupTest()
+	t0 := time.Unix(1640000000, 0).UTC()
+	suite.ctx = suite.ctx.WithBlockTime(t0)
+
+	_, err := suite.app.GAMMKeeper.CalculateTimeWeightedSpotPrice(suite.ctx, 1, "foo", "bar", time.Minute)
+	suite.Require().True(errors.Is(err, types.ErrNoPriceHistory), err)
+
+	// the pool has no records before the end of the block of its creation
+	poolId := suite.prepareBalancerPool()
+	_, err = suite.app.GAMMKeeper.CalculateTimeWeightedSpotPrice(suite.ctx, poolId, "foo", "bar", time.Minute)
+	suite.Require().True(errors.Is(err, types.ErrNoPriceHistory), err)
+
+	endBlock := func() {
+		suite.app.GAMMKeeper.RecordChangedPoolsTwap(suite.ctx)
+	}
+	twap := func(window time.Duration) sdk.Dec {
+		price, err := suite.app.GAMMKeeper.CalculateTimeWeightedSpotPrice(suite.ctx, poolId, "foo", "bar", window)
+		suite.Require().NoError(err)
+		return price
+	}
+	// the spot prices are computed from balance / weight ratios rounded to the 18 decimals of sdk.Dec,
+	// which for the small ratios of the test pool only leaves about 14 significant digits
+	requireApproxEqual := func(expected, actual sdk.Dec) {
+		suite.Require().True(expected.Sub(actual).Abs().LTE(sdk.NewDecWithPrec(1, 12)), "expected %s, got %s", expected, actual)
+	}
+	swap := func() sdk.Dec {
+		_, _, err := suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewInt64Coin("foo", 1000000), "bar", sdk.OneInt())
+		suite.Require().NoError(err)
+		price, err := suite.app.GAMMKeeper.CalculateSpotPrice(suite.ctx, poolId, "foo", "bar")
+		suite.Require().NoError(err)
+		return price
+	}
+
+	// at the end of the block of the pool creation, there is only the spot price
+	endBlock()
+	p0 := sdk.NewDec(2)
+	requireApproxEqual(p0, twap(time.Minute))
+
+	// a swap has no weight in its own block
+	suite.ctx = suite.ctx.WithBlockTime(t0.Add(10 * time.Minute))
+	p1 := swap()
+	suite.Require().NotEqual(p0.String(), p1.String())
+	requireApproxEqual(p0, twap(5*time.Minute))
+	requireApproxEqual(p0, twap(20*time.Minute))
+	endBlock()
+
+	// the geometric mean of the prices is weighted by how long each was in effect,
+	// and windows from before the pool creation only cover the time since
+	suite.ctx = suite.ctx.WithBlockTime(t0.Add(20 * time.Minute))
+	average, err := p0.Mul(p1).ApproxSqrt()
+	suite.Require().NoError(err)
+	requireApproxEqual(p1, twap(10*time.Minute))
+	requireApproxEqual(average, twap(20*time.Minute))
+	requireApproxEqual(average, twap(time.Hour))
+
+	// the start of the window may fall between records, here p0 is in effect
+	// for 6 minutes of the window and p1 for 10 minutes
+	average = p0.Power(3).Mul(p1.Power(5))
+	for i := 0; i < 3; i++ {
+		average, err = average.ApproxSqrt()
+		suite.Require().NoError(err)
+	}
+	requireApproxEqual(average, twap(16*time.Minute))
+
+	// records older than the retention are pruned, except the one in effect at the cutoff
+	suite.ctx = suite.ctx.WithBlockTime(t0.Add(types.TwapRecordRetention + time.Hour))
+	swap()
+	endBlock()
+	requireApproxEqual(p1, twap(3*time.Hour))
+
+	_, err = suite.app.GAMMKeeper.CalculateTimeWeightedSpotPrice(suite.ctx, poolId, "foo", "bar", 0)
+	suite.Require().Error(err)
+	_, err = suite.app.GAMMKeeper.CalculateTimeWeightedSpotPrice(suite.ctx, poolId, "foo", "uosmo", time.Minute)
+	suite.Require().Error(err)
+}
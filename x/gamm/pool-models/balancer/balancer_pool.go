@@ -16,7 +16,8 @@ import (
 // NewPool returns a weighted CPMM pool with the provided parameters, and initial assets.
 // Invariants that are assumed to be satisfied and not checked:
 // (This is handled in ValidateBasic)
-// * 2 <= len(assets) <= 8
+// * 2 <= len(assets) <= MaxPoolAssetsUpperBound
+// (the MinPoolAssets and MaxPoolAssets params are checked by the keeper)
 // * FutureGovernor is valid
 // * poolID doesn't already exist
 func NewBalancerPool(poolId uint64, balancerPoolParams BalancerPoolParams, assets []types.PoolAsset, futureGovernor string, blockTime time.Time) (BalancerPool, error) {
@@ -322,6 +322,74 @@ func TestGetBalancerPoolAssets(t *testing.T) {
 	require.Equal(t, 0, len(assets))
 }
 
+// largePoolAssets returns numAssets pool assets with zero-padded denoms,
+// given to the pool in reverse order so the pool has to sort them.
+func largePoolAssets(numAssets int) []types.PoolAsset {
+	assets := make([]types.PoolAsset, 0, numAssets)
+	for i := numAssets; i >= 1; i-- {
+		assets = append(assets, types.PoolAsset{
+			Weight: sdk.NewInt(int64(100 * i)),
+			Token:  sdk.NewCoin(fmt.Sprintf("test%02d", i), sdk.NewInt(int64(10000*i))),
+		})
+	}
+	return assets
+}
+
+func TestGetPoolAssetAndIndexLargePool(t *testing.T) {
+	for _, numAssets := range []int{8, 12, 16, types.MaxPoolAssetsUpperBound} {
+		pacc, err := NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, largePoolAssets(numAssets), defaultFutureGovernor, defaultCurBlockTime)
+		require.NoError(t, err)
+		require.Equal(t, numAssets, pacc.NumAssets())
+
+		expectedTotalWeight := sdk.ZeroInt()
+		for i := 1; i <= numAssets; i++ {
+			denom := fmt.Sprintf("test%02d", i)
+			index, asset, err := pacc.getPoolAssetAndIndex(denom)
+			require.NoError(t, err, "numAssets %v, denom %v", numAssets, denom)
+			require.Equal(t, i-1, index, "numAssets %v, denom %v", numAssets, denom)
+			require.Equal(t, denom, asset.Token.Denom)
+			require.Equal(t, sdk.NewInt(int64(10000*i)), asset.Token.Amount)
+			expectedTotalWeight = expectedTotalWeight.AddRaw(int64(100 * i))
+		}
+		testTotalWeight(t, expectedTotalWeight, pacc)
+
+		// denoms sorting before, between and after the pool assets must not be found
+		for _, denom := range []string{"", "aaa", "test00", "test015", "test1", fmt.Sprintf("test%02d", numAssets+1), "zzz"} {
+			index, _, err := pacc.getPoolAssetAndIndex(denom)
+			require.Error(t, err, "numAssets %v, denom %v", numAssets, denom)
+			require.Equal(t, -1, index)
+		}
+	}
+}
+
+func TestUpdateAllWeightsLargePool(t *testing.T) {
+	for _, numAssets := range []int{8, 12, 16, types.MaxPoolAssetsUpperBound} {
+		pacc, err := NewBalancerPool(defaultPoolId, defaultBalancerPoolParams, largePoolAssets(numAssets), defaultFutureGovernor, defaultCurBlockTime)
+		require.NoError(t, err)
+
+		// give every asset the max user specified weight, to check the total
+		// weight of the largest pools stays representable
+		newWeights := pacc.GetAllPoolAssets()
+		maxWeight := types.MaxUserSpecifiedWeight.MulRaw(types.GuaranteedWeightPrecision)
+		for i := range newWeights {
+			newWeights[i].Weight = maxWeight
+		}
+		pacc.updateAllWeights(newWeights)
+
+		for i, asset := range pacc.GetAllPoolAssets() {
+			require.Equal(t, maxWeight, asset.Weight)
+			require.Equal(t, fmt.Sprintf("test%02d", i+1), asset.Token.Denom)
+		}
+		require.Equal(t, maxWeight.MulRaw(int64(numAssets)), pacc.GetTotalWeight())
+
+		// a weight list missing an asset, or with a mismatched denom, panics
+		require.Panics(t, func() { pacc.updateAllWeights(newWeights[1:]) })
+		mismatched := pacc.GetAllPoolAssets()
+		mismatched[numAssets-1].Token.Denom = "zzz"
+		require.Panics(t, func() { pacc.updateAllWeights(mismatched) })
+	}
+}
+
 func TestLBPParamsEmptyStartTime(t *testing.T) {
 	// Test that when the start time is empty, the pool
 	// sets its start time to be the first start time it is called on
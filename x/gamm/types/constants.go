@@ -1,14 +1,27 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
+	// MinPoolAssets is the absolute minimum number of assets in a pool,
+	// as a pool must be swapping between at least two assets.
 	MinPoolAssets = 2
-	MaxPoolAssets = 8
+	// DefaultMaxPoolAssets is the default value of the MaxPoolAssets param.
+	DefaultMaxPoolAssets = 8
+	// MaxPoolAssetsUpperBound is the largest value governance can set the
+	// MaxPoolAssets param to. It bounds the per-pool iteration done in the
+	// balancer math and the gamm invariants.
+	MaxPoolAssetsUpperBound = 32
 
 	OneShareExponent = 18
+
+	// TwapRecordRetention is how long the time weighted price accumulators of pools
+	// are kept, which bounds the window of time weighted spot prices.
+	TwapRecordRetention = time.Hour
 )
 
 var (
@@ -7,12 +7,14 @@ var (
 	ErrPoolNotFound       = sdkerrors.Register(ModuleName, 1, "pool not found")
 	ErrPoolAlreadyExist   = sdkerrors.Register(ModuleName, 2, "pool already exist")
 	ErrPoolLocked         = sdkerrors.Register(ModuleName, 3, "pool is locked")
-	ErrTooFewPoolAssets   = sdkerrors.Register(ModuleName, 4, "pool should have at least 2 assets, as they must be swapping between at least two assets")
-	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets (currently capped at 8 assets per pool)")
+	ErrTooFewPoolAssets   = sdkerrors.Register(ModuleName, 4, "pool has too few assets")
+	ErrTooManyPoolAssets  = sdkerrors.Register(ModuleName, 5, "pool has too many assets")
 	ErrLimitMaxAmount     = sdkerrors.Register(ModuleName, 6, "calculated amount is larger than max amount")
 	ErrLimitMinAmount     = sdkerrors.Register(ModuleName, 7, "calculated amount is lesser than min amount")
 	ErrInvalidMathApprox  = sdkerrors.Register(ModuleName, 8, "invalid calculated result")
 	ErrAlreadyInvalidPool = sdkerrors.Register(ModuleName, 9, "destruction on already invalid pool")
+	ErrPoolAssetsMismatch = sdkerrors.Register(ModuleName, 10, "pools do not contain the same assets")
+	ErrNoPriceHistory     = sdkerrors.Register(ModuleName, 11, "pool has no price history")
 
 	ErrEmptyRoutes              = sdkerrors.Register(ModuleName, 21, "routes not defined")
 	ErrEmptyPoolAssets          = sdkerrors.Register(ModuleName, 22, "PoolAssets not defined")
@@ -30,6 +30,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// min_pool_assets is the minimum number of assets a pool can be created with
+	MinPoolAssets uint64 `protobuf:"varint,2,opt,name=min_pool_assets,json=minPoolAssets,proto3" json:"min_pool_assets,omitempty" yaml:"min_pool_assets"`
+	// max_pool_assets is the maximum number of assets a pool can be created with
+	MaxPoolAssets uint64 `protobuf:"varint,3,opt,name=max_pool_assets,json=maxPoolAssets,proto3" json:"max_pool_assets,omitempty" yaml:"max_pool_assets"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,6 +76,20 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetMinPoolAssets() uint64 {
+	if m != nil {
+		return m.MinPoolAssets
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPoolAssets() uint64 {
+	if m != nil {
+		return m.MaxPoolAssets
+	}
+	return 0
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools          []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
@@ -143,32 +161,35 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x52, 0x3d, 0xeb, 0xd3, 0x40,
-	0x18, 0xcf, 0xf9, 0xff, 0xb7, 0x60, 0x2c, 0xbe, 0x84, 0x0e, 0x69, 0x87, 0xb4, 0x64, 0xca, 0x60,
-	0xef, 0x68, 0xc5, 0xc5, 0xcd, 0x14, 0x2a, 0x82, 0x48, 0x89, 0x9b, 0x4b, 0xb8, 0xc4, 0x6b, 0x0c,
-	0x26, 0xf7, 0x84, 0xdc, 0x55, 0x9a, 0x6f, 0x21, 0xb8, 0xbb, 0xb8, 0x39, 0xfb, 0x21, 0x8a, 0x53,
-	0x47, 0xa7, 0x2a, 0xed, 0x37, 0xf0, 0x13, 0xc8, 0xbd, 0x54, 0x0a, 0x4e, 0xc9, 0xef, 0x7e, 0x2f,
-	0xf7, 0x3c, 0xbf, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7, 0xe4, 0xe3, 0x3c,
-	0x63, 0x92, 0xce, 0x49, 0xc1, 0x38, 0x13, 0xa5, 0xc0, 0x4d, 0x0b, 0x12, 0xbc, 0x81, 0xd5, 0x60,
-	0xa5, 0x19, 0x0f, 0x0b, 0x28, 0x40, 0x13, 0x44, 0xbd, 0x19, 0xcd, 0x78, 0x54, 0x00, 0x14, 0x15,
-	0x23, 0x1a, 0x65, 0xdb, 0x0d, 0xa1, 0xbc, 0xbb, 0x50, 0xb9, 0xf6, 0xa7, 0xc6, 0x63, 0x80, 0xa5,
-	0x02, 0x83, 0x48, 0x46, 0x05, 0xfb, 0x77, 0x79, 0x0e, 0x25, 0x37, 0x7c, 0xf8, 0x05, 0xb9, 0xfd,
-	0x35, 0x6d, 0x69, 0x2d, 0xbc, 0xcf, 0xc8, 0x7d, 0xd4, 0x00, 0x54, 0x69, 0xde, 0x32, 0x2a, 0x4b,
-	0xe0, 0xe9, 0x86, 0x31, 0x1f, 0x4d, 0x6f, 0xa2, 0x7b, 0x8b, 0x11, 0xb6, 0xa9, 0x2a, 0x07, 0xdb,
-	0x1c, 0xbc, 0x84, 0x92, 0xc7, 0xaf, 0xf6, 0xc7, 0x89, 0xf3, 0xe7, 0x38, 0xf1, 0x3b, 0x5a, 0x57,
-	0xcf, 0xc2, 0xff, 0x12, 0xc2, 0x6f, 0xbf, 0x26, 0x51, 0x51, 0xca, 0xf7, 0xdb, 0x0c, 0xe7, 0x50,
-	0xdb, 0xf1, 0xec, 0x63, 0x26, 0xde, 0x7d, 0x20, 0xb2, 0x6b, 0x98, 0xd0, 0x61, 0x22, 0x79, 0xa0,
-	0xfc, 0x4b, 0x6b, 0x5f, 0x31, 0x16, 0x7e, 0x45, 0xee, 0xe0, 0x85, 0x29, 0xeb, 0x8d, 0xa4, 0x92,
-	0x79, 0x4f, 0xdd, 0x9e, 0xd2, 0x08, 0x3b, 0xd9, 0x10, 0x9b, 0x5e, 0xf0, 0xa5, 0x17, 0xfc, 0x9c,
-	0x77, 0xf1, 0xdd, 0x1f, 0xdf, 0x67, 0xbd, 0x35, 0x40, 0xf5, 0x32, 0x31, 0x6a, 0x2f, 0x72, 0x1f,
-	0x72, 0xb6, 0x93, 0xa9, 0x9e, 0x8f, 0x6f, 0xeb, 0x8c, 0xb5, 0xfe, 0x9d, 0x29, 0x8a, 0x6e, 0x93,
-	0xfb, 0xea, 0x5c, 0x69, 0x5f, 0xeb, 0x53, 0x6f, 0xe1, 0xf6, 0x1b, 0xdd, 0x88, 0x7f, 0x33, 0x45,
-	0xfa, 0x86, 0xeb, 0xaf, 0x83, 0x4d, 0x5b, 0xf1, 0xad, 0x5a, 0x3b, 0xb1, 0xca, 0x78, 0xb5, 0x3f,
-	0x05, 0xe8, 0x70, 0x0a, 0xd0, 0xef, 0x53, 0x80, 0x3e, 0x9d, 0x03, 0xe7, 0x70, 0x0e, 0x9c, 0x9f,
-	0xe7, 0xc0, 0x79, 0xfb, 0xf8, 0x6a, 0x75, 0x9b, 0x33, 0xab, 0x68, 0x26, 0x2e, 0x80, 0xec, 0xcc,
-	0x8f, 0xa1, 0x4b, 0xc8, 0xfa, 0x7a, 0x8b, 0x27, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x6a,
-	0x74, 0x74, 0x35, 0x02, 0x00, 0x00,
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0xcb, 0x6e, 0xd4, 0x30,
+	0x14, 0x86, 0x27, 0xbd, 0x8c, 0x84, 0x29, 0x14, 0xa2, 0x0a, 0x4d, 0x67, 0x91, 0x54, 0x59, 0x65,
+	0xc1, 0xd8, 0x6a, 0x11, 0x1b, 0x76, 0x4d, 0xa5, 0x22, 0x24, 0x84, 0xaa, 0xb0, 0x63, 0x13, 0x39,
+	0xc1, 0x0d, 0x16, 0xb1, 0x4f, 0x14, 0x7b, 0x50, 0xf2, 0x16, 0x48, 0x3c, 0x02, 0x12, 0x0b, 0xd6,
+	0x3c, 0x44, 0xc5, 0xaa, 0x4b, 0x56, 0x03, 0x9a, 0x79, 0x83, 0x3e, 0x01, 0xf2, 0x65, 0xa0, 0x97,
+	0x55, 0xf2, 0xfb, 0xfc, 0xe7, 0x3b, 0xb6, 0x7f, 0xa3, 0x04, 0x94, 0x00, 0xc5, 0x15, 0xa9, 0xa9,
+	0x10, 0xe4, 0xd3, 0x61, 0xc9, 0x34, 0x3d, 0x24, 0x35, 0x93, 0x4c, 0x71, 0x85, 0xdb, 0x0e, 0x34,
+	0x84, 0x3b, 0xde, 0x83, 0x8d, 0x67, 0xba, 0x57, 0x43, 0x0d, 0xb6, 0x40, 0xcc, 0x9f, 0xf3, 0x4c,
+	0xf7, 0x6b, 0x80, 0xba, 0x61, 0xc4, 0xaa, 0x72, 0x7e, 0x4e, 0xa8, 0x1c, 0xd6, 0xa5, 0xca, 0xf6,
+	0x17, 0xae, 0xc7, 0x09, 0x5f, 0x8a, 0x9c, 0x22, 0x25, 0x55, 0xec, 0xdf, 0xf0, 0x0a, 0xb8, 0x74,
+	0xf5, 0xe4, 0xdb, 0x06, 0x1a, 0x9f, 0xd1, 0x8e, 0x0a, 0x15, 0x7e, 0x09, 0xd0, 0xe3, 0x16, 0xa0,
+	0x29, 0xaa, 0x8e, 0x51, 0xcd, 0x41, 0x16, 0xe7, 0x8c, 0x4d, 0x82, 0x83, 0xcd, 0xf4, 0xfe, 0xd1,
+	0x3e, 0xf6, 0x54, 0xc3, 0xc1, 0x9e, 0x83, 0x4f, 0x80, 0xcb, 0xec, 0xf5, 0xc5, 0x22, 0x1e, 0x5d,
+	0x2d, 0xe2, 0xc9, 0x40, 0x45, 0xf3, 0x22, 0xb9, 0x43, 0x48, 0xbe, 0xff, 0x8e, 0xd3, 0x9a, 0xeb,
+	0x0f, 0xf3, 0x12, 0x57, 0x20, 0xfc, 0xf6, 0xfc, 0x67, 0xa6, 0xde, 0x7f, 0x24, 0x7a, 0x68, 0x99,
+	0xb2, 0x30, 0x95, 0xef, 0x9a, 0xfe, 0x13, 0xdf, 0x7e, 0xca, 0x58, 0x98, 0xa1, 0x5d, 0xc1, 0x65,
+	0x61, 0xb1, 0x54, 0x29, 0xa6, 0xd5, 0x64, 0xe3, 0x20, 0x48, 0xb7, 0xb2, 0xe9, 0xd5, 0x22, 0x7e,
+	0xe2, 0x66, 0xde, 0x32, 0x24, 0xf9, 0x03, 0xc1, 0xe5, 0x19, 0x40, 0x73, 0x6c, 0xb5, 0x65, 0xd0,
+	0xfe, 0x06, 0x63, 0xf3, 0x0e, 0x83, 0xf6, 0xb7, 0x19, 0xb4, 0xff, 0xcf, 0x48, 0xbe, 0x06, 0x68,
+	0xe7, 0xa5, 0x0b, 0xed, 0xad, 0xa6, 0x9a, 0x85, 0xcf, 0xd1, 0xb6, 0xf1, 0x2b, 0x7f, 0x43, 0x7b,
+	0xd8, 0xe5, 0x83, 0xd7, 0xf9, 0xe0, 0x63, 0x39, 0x64, 0xf7, 0x7e, 0xfe, 0x98, 0x6d, 0x1b, 0xca,
+	0xab, 0xdc, 0xb9, 0xc3, 0x14, 0x3d, 0x92, 0xac, 0xd7, 0x6e, 0x96, 0x9c, 0x8b, 0x92, 0x75, 0xee,
+	0x40, 0xf9, 0x43, 0xb3, 0x6e, 0xbc, 0x6f, 0xec, 0x6a, 0x78, 0x84, 0xc6, 0xad, 0x4d, 0xc6, 0x6e,
+	0xd6, 0x4c, 0xb8, 0xfe, 0x4a, 0xb0, 0x4b, 0x2d, 0xdb, 0x32, 0xd7, 0x9f, 0x7b, 0x67, 0x76, 0x7a,
+	0xb1, 0x8c, 0x82, 0xcb, 0x65, 0x14, 0xfc, 0x59, 0x46, 0xc1, 0xe7, 0x55, 0x34, 0xba, 0x5c, 0x45,
+	0xa3, 0x5f, 0xab, 0x68, 0xf4, 0xee, 0xe9, 0xb5, 0x08, 0x3c, 0x67, 0xd6, 0xd0, 0x52, 0xad, 0x05,
+	0xe9, 0xdd, 0x03, 0xb5, 0x61, 0x94, 0x63, 0x7b, 0x8a, 0x67, 0x7f, 0x07, 0x00, 0x30, 0xf1, 0x62,
+	0xe1, 0xbd, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -191,6 +212,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPoolAssets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPoolAssets))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MinPoolAssets != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinPoolAssets))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -283,6 +314,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.MinPoolAssets != 0 {
+		n += 1 + sovGenesis(uint64(m.MinPoolAssets))
+	}
+	if m.MaxPoolAssets != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPoolAssets))
+	}
 	return n
 }
 
@@ -375,6 +412,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolAssets", wireType)
+			}
+			m.MinPoolAssets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoolAssets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolAssets", wireType)
+			}
+			m.MaxPoolAssets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolAssets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
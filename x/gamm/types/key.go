@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -13,6 +14,9 @@ const (
 
 	StoreKey = ModuleName
 
+	// TStoreKey defines the transient store key, which tracks the pools changed in the current block
+	TStoreKey = "transient_" + ModuleName
+
 	RouterKey = ModuleName
 
 	QuerierRoute = ModuleName
@@ -25,6 +29,11 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixTwapRecords defines prefix to store the time weighted price accumulators of pools
+	KeyPrefixTwapRecords = []byte{0x04}
+
+	// KeyPrefixChangedPools defines prefix of the transient store to track the pools changed in the current block
+	KeyPrefixChangedPools = []byte{0x01}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +65,15 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyChangedPool(poolId uint64) []byte {
+	return append(KeyPrefixChangedPools, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPrefixTwapRecords(poolId uint64) []byte {
+	return append(KeyPrefixTwapRecords, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyTwapRecord(poolId uint64, blockTime time.Time) []byte {
+	return append(GetKeyPrefixTwapRecords(poolId), sdk.FormatTimeBytes(blockTime)...)
+}
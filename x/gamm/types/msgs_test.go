@@ -867,3 +867,104 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgMigrateLiquidity(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg MsgMigrateLiquidity) MsgMigrateLiquidity) MsgMigrateLiquidity {
+		properMsg := MsgMigrateLiquidity{
+			Sender:            addr1,
+			PoolIdFrom:        1,
+			PoolIdTo:          2,
+			ShareInAmount:     sdk.NewInt(10),
+			TokenOutMins:      sdk.NewCoins(sdk.NewCoin("test1", sdk.NewInt(10)), sdk.NewCoin("test2", sdk.NewInt(20))),
+			ShareOutMinAmount: sdk.NewInt(5),
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), RouterKey)
+	require.Equal(t, msg.Type(), "migrate_liquidity")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        MsgMigrateLiquidity
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same pool",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.PoolIdTo = msg.PoolIdFrom
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative requirement",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.ShareInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative token min out",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.TokenOutMins[1].Amount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "'empty token min out' can pass",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.TokenOutMins = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg MsgMigrateLiquidity) MsgMigrateLiquidity {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -11,6 +11,8 @@ import (
 // Parameter store keys
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyMinPoolAssets   = []byte("MinPoolAssets")
+	KeyMaxPoolAssets   = []byte("MaxPoolAssets")
 )
 
 // ParamTable for gamm module.
@@ -18,9 +20,11 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, minPoolAssets, maxPoolAssets uint64) Params {
 	return Params{
 		PoolCreationFee: poolCreationFee,
+		MinPoolAssets:   minPoolAssets,
+		MaxPoolAssets:   maxPoolAssets,
 	}
 }
 
@@ -28,6 +32,8 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		MinPoolAssets:   MinPoolAssets,
+		MaxPoolAssets:   DefaultMaxPoolAssets,
 	}
 }
 
@@ -37,6 +43,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinPoolAssets(p.MinPoolAssets); err != nil {
+		return err
+	}
+
+	if err := validateMaxPoolAssets(p.MaxPoolAssets); err != nil {
+		return err
+	}
+
+	if p.MinPoolAssets > p.MaxPoolAssets {
+		return fmt.Errorf("min pool assets (%d) must not exceed max pool assets (%d)", p.MinPoolAssets, p.MaxPoolAssets)
+	}
+
 	return nil
 
 }
@@ -45,6 +63,8 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyMinPoolAssets, &p.MinPoolAssets, validateMinPoolAssets),
+		paramtypes.NewParamSetPair(KeyMaxPoolAssets, &p.MaxPoolAssets, validateMaxPoolAssets),
 	}
 }
 
@@ -60,3 +80,33 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMinPoolAssets(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < MinPoolAssets {
+		return fmt.Errorf("min pool assets must be at least %d: %d", MinPoolAssets, v)
+	}
+
+	return nil
+}
+
+func validateMaxPoolAssets(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < MinPoolAssets {
+		return fmt.Errorf("max pool assets must be at least %d: %d", MinPoolAssets, v)
+	}
+
+	if v > MaxPoolAssetsUpperBound {
+		return fmt.Errorf("max pool assets must not exceed %d: %d", MaxPoolAssetsUpperBound, v)
+	}
+
+	return nil
+}
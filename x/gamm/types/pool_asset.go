@@ -77,12 +77,13 @@ func SortPoolAssetsByDenom(assets []PoolAsset) {
 
 func ValidateUserSpecifiedPoolAssets(assets []PoolAsset) error {
 	// The pool must be swapping between at least two assets
-	if len(assets) < 2 {
+	if len(assets) < MinPoolAssets {
 		return ErrTooFewPoolAssets
 	}
 
-	// TODO: Add the limit of binding token to the pool params?
-	if len(assets) > 8 {
+	// The exact limit is a governance param checked at pool creation,
+	// here we only reject sizes no param value could allow.
+	if len(assets) > MaxPoolAssetsUpperBound {
 		return sdkerrors.Wrapf(ErrTooManyPoolAssets, "%d", len(assets))
 	}
 
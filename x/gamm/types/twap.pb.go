@@ -0,0 +1,610 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/v1beta1/twap.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TwapRecord holds the time weighted price accumulators of a pool at the end
+// of a block. Its block time is part of its store key.
+type TwapRecord struct {
+	Accumulators []TwapAccumulator `protobuf:"bytes,1,rep,name=accumulators,proto3" json:"accumulators" yaml:"accumulators"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_989dc2b64142890f, []int{0}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetAccumulators() []TwapAccumulator {
+	if m != nil {
+		return m.Accumulators
+	}
+	return nil
+}
+
+// TwapAccumulator is the time weighted price accumulator of a pool asset.
+type TwapAccumulator struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// ln_normalized_balance is ln(balance / weight) of the asset at the end of
+	// the block, which is in effect until the next record
+	LnNormalizedBalance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=ln_normalized_balance,json=lnNormalizedBalance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"ln_normalized_balance" yaml:"ln_normalized_balance"`
+	// accumulator is the sum of ln(balance / weight) of the asset times the
+	// nanoseconds it was in effect, from the pool creation to the block time
+	Accumulator github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=accumulator,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"accumulator" yaml:"accumulator"`
+}
+
+func (m *TwapAccumulator) Reset()         { *m = TwapAccumulator{} }
+func (m *TwapAccumulator) String() string { return proto.CompactTextString(m) }
+func (*TwapAccumulator) ProtoMessage()    {}
+func (*TwapAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_989dc2b64142890f, []int{1}
+}
+func (m *TwapAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapAccumulator.Merge(m, src)
+}
+func (m *TwapAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapAccumulator proto.InternalMessageInfo
+
+func (m *TwapAccumulator) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TwapRecord)(nil), "osmosis.gamm.v1beta1.TwapRecord")
+	proto.RegisterType((*TwapAccumulator)(nil), "osmosis.gamm.v1beta1.TwapAccumulator")
+}
+
+func init() { proto.RegisterFile("osmosis/gamm/v1beta1/twap.proto", fileDescriptor_989dc2b64142890f) }
+
+var fileDescriptor_989dc2b64142890f = []byte{
+	// 350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x4e, 0xeb, 0x30,
+	0x14, 0x86, 0x93, 0x56, 0xf7, 0x4a, 0xd7, 0xad, 0x74, 0xaf, 0xd2, 0x5e, 0x29, 0x02, 0x94, 0x54,
+	0x91, 0xa8, 0x3a, 0xd0, 0x58, 0x85, 0x8d, 0x8d, 0xa8, 0x62, 0xec, 0x10, 0x31, 0xb1, 0x54, 0x8e,
+	0xe3, 0x86, 0x0a, 0x3b, 0x8e, 0x62, 0x97, 0x52, 0x46, 0x9e, 0x80, 0xa7, 0xe0, 0x59, 0x3a, 0x76,
+	0x44, 0x0c, 0x11, 0x6a, 0xdf, 0xa0, 0x4f, 0x80, 0xe2, 0x04, 0x08, 0xd0, 0x85, 0x29, 0xf1, 0xf1,
+	0xff, 0x7f, 0xbf, 0x8f, 0x8f, 0x81, 0xcd, 0x05, 0xe3, 0x62, 0x2a, 0x60, 0x84, 0x18, 0x83, 0x37,
+	0x83, 0x80, 0x48, 0x34, 0x80, 0x72, 0x8e, 0x12, 0x37, 0x49, 0xb9, 0xe4, 0x46, 0xbb, 0x14, 0xb8,
+	0xb9, 0xc0, 0x2d, 0x05, 0x7b, 0xed, 0x88, 0x47, 0x5c, 0x09, 0x60, 0xfe, 0x57, 0x68, 0x1d, 0x09,
+	0xc0, 0xc5, 0x1c, 0x25, 0x3e, 0xc1, 0x3c, 0x0d, 0x8d, 0x09, 0x68, 0x22, 0x8c, 0x67, 0x6c, 0x46,
+	0x91, 0xe4, 0xa9, 0x30, 0xf5, 0x4e, 0xbd, 0xd7, 0x38, 0x3e, 0x74, 0x77, 0x01, 0xdd, 0xdc, 0x77,
+	0xf6, 0xa1, 0xf6, 0xf6, 0x97, 0x99, 0xad, 0x6d, 0x33, 0xbb, 0xb5, 0x40, 0x8c, 0x9e, 0x3a, 0x55,
+	0x90, 0xe3, 0x7f, 0xe2, 0x3a, 0x8f, 0x35, 0xf0, 0xf7, 0x8b, 0xdd, 0xe8, 0x82, 0x5f, 0x21, 0x89,
+	0x39, 0x33, 0xf5, 0x8e, 0xde, 0xfb, 0xe3, 0xfd, 0xdb, 0x66, 0x76, 0xb3, 0x20, 0xa9, 0xb2, 0xe3,
+	0x17, 0xdb, 0xc6, 0xbd, 0x0e, 0xfe, 0xd3, 0x78, 0x1c, 0xf3, 0x94, 0x21, 0x3a, 0xbd, 0x23, 0xe1,
+	0x38, 0x40, 0x14, 0xc5, 0x98, 0x98, 0x35, 0x65, 0x1c, 0xe5, 0xc7, 0x78, 0xce, 0xec, 0x6e, 0x34,
+	0x95, 0x57, 0xb3, 0xc0, 0xc5, 0x9c, 0x41, 0xac, 0x1a, 0x28, 0x3f, 0x7d, 0x11, 0x5e, 0x43, 0xb9,
+	0x48, 0x88, 0x70, 0x87, 0x04, 0x6f, 0x33, 0xfb, 0xa0, 0x88, 0xd9, 0x09, 0x75, 0xfc, 0x16, 0x8d,
+	0x47, 0xef, 0x65, 0xaf, 0xa8, 0x1a, 0x13, 0xd0, 0xa8, 0x34, 0x64, 0xd6, 0x55, 0xf2, 0xf0, 0xc7,
+	0xc9, 0xc6, 0xb7, 0xab, 0x72, 0xfc, 0x2a, 0xd8, 0x3b, 0x5f, 0xae, 0x2d, 0x7d, 0xb5, 0xb6, 0xf4,
+	0x97, 0xb5, 0xa5, 0x3f, 0x6c, 0x2c, 0x6d, 0xb5, 0xb1, 0xb4, 0xa7, 0x8d, 0xa5, 0x5d, 0x1e, 0x55,
+	0x42, 0xca, 0xf1, 0xf4, 0x29, 0x0a, 0xc4, 0xdb, 0x02, 0xde, 0x16, 0xef, 0x43, 0xc5, 0x05, 0xbf,
+	0xd5, 0xb4, 0x4f, 0x5e, 0x07, 0x00, 0xac, 0x08, 0x65, 0xb1, 0x3c, 0x02, 0x00, 0x00,
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accumulators) > 0 {
+		for iNdEx := len(m.Accumulators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accumulators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTwap(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Accumulator.Size()
+		i -= size
+		if _, err := m.Accumulator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LnNormalizedBalance.Size()
+		i -= size
+		if _, err := m.LnNormalizedBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTwap(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTwap(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTwap(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTwap(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accumulators) > 0 {
+		for _, e := range m.Accumulators {
+			l = e.Size()
+			n += 1 + l + sovTwap(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TwapAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTwap(uint64(l))
+	}
+	l = m.LnNormalizedBalance.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	l = m.Accumulator.Size()
+	n += 1 + l + sovTwap(uint64(l))
+	return n
+}
+
+func sovTwap(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTwap(x uint64) (n int) {
+	return sovTwap(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accumulators = append(m.Accumulators, TwapAccumulator{})
+			if err := m.Accumulators[len(m.Accumulators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LnNormalizedBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LnNormalizedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accumulator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTwap
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accumulator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTwap(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTwap
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTwap(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTwap
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTwap
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTwap
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTwap
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTwap
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTwap        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTwap          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTwap = fmt.Errorf("proto: unexpected end of group")
+)
@@ -920,6 +920,114 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgMigrateLiquidity
+type MsgMigrateLiquidity struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIdFrom        uint64                                 `protobuf:"varint,2,opt,name=poolIdFrom,proto3" json:"poolIdFrom,omitempty" yaml:"pool_id_from"`
+	PoolIdTo          uint64                                 `protobuf:"varint,3,opt,name=poolIdTo,proto3" json:"poolIdTo,omitempty" yaml:"pool_id_to"`
+	ShareInAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shareInAmount" yaml:"share_in_amount"`
+	TokenOutMins      []types.Coin                           `protobuf:"bytes,5,rep,name=tokenOutMins,proto3" json:"tokenOutMins" yaml:"token_out_min_amounts"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shareOutMinAmount" yaml:"share_out_min_amount"`
+}
+
+func (m *MsgMigrateLiquidity) Reset()         { *m = MsgMigrateLiquidity{} }
+func (m *MsgMigrateLiquidity) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLiquidity) ProtoMessage()    {}
+func (*MsgMigrateLiquidity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *MsgMigrateLiquidity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLiquidity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLiquidity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLiquidity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLiquidity.Merge(m, src)
+}
+func (m *MsgMigrateLiquidity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLiquidity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLiquidity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLiquidity proto.InternalMessageInfo
+
+func (m *MsgMigrateLiquidity) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateLiquidity) GetPoolIdFrom() uint64 {
+	if m != nil {
+		return m.PoolIdFrom
+	}
+	return 0
+}
+
+func (m *MsgMigrateLiquidity) GetPoolIdTo() uint64 {
+	if m != nil {
+		return m.PoolIdTo
+	}
+	return 0
+}
+
+func (m *MsgMigrateLiquidity) GetTokenOutMins() []types.Coin {
+	if m != nil {
+		return m.TokenOutMins
+	}
+	return nil
+}
+
+type MsgMigrateLiquidityResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"shareOutAmount" yaml:"share_out_amount"`
+}
+
+func (m *MsgMigrateLiquidityResponse) Reset()         { *m = MsgMigrateLiquidityResponse{} }
+func (m *MsgMigrateLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateLiquidityResponse) ProtoMessage()    {}
+func (*MsgMigrateLiquidityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateLiquidityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateLiquidityResponse.Merge(m, src)
+}
+func (m *MsgMigrateLiquidityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateLiquidityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateLiquidityResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -939,76 +1047,85 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*MsgMigrateLiquidity)(nil), "osmosis.gamm.v1beta1.MsgMigrateLiquidity")
+	proto.RegisterType((*MsgMigrateLiquidityResponse)(nil), "osmosis.gamm.v1beta1.MsgMigrateLiquidityResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1018 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0xda, 0x6e, 0xda, 0xbe, 0x21, 0xa5, 0x59, 0x92, 0xc6, 0xd9, 0xb6, 0xde, 0x74, 0x5a,
-	0x41, 0x5a, 0xe8, 0x2e, 0x4d, 0x25, 0x40, 0x48, 0x20, 0x30, 0xa4, 0xc2, 0xa8, 0x96, 0xd1, 0xf6,
-	0x82, 0xb8, 0x98, 0xb5, 0xbd, 0x72, 0x57, 0xcd, 0xce, 0x18, 0xcf, 0x6c, 0x71, 0xc5, 0x81, 0x0f,
-	0x89, 0x3b, 0xbf, 0x00, 0xf1, 0x33, 0xe8, 0x01, 0xce, 0x3d, 0x56, 0x42, 0x48, 0x88, 0x83, 0x85,
-	0x92, 0x7f, 0xe0, 0x5f, 0x80, 0x76, 0x67, 0x76, 0xbd, 0x9f, 0xb6, 0xb7, 0x8d, 0x73, 0x4a, 0xe2,
-	0x79, 0xe7, 0x79, 0xdf, 0x79, 0x9e, 0xf7, 0x2b, 0x86, 0xab, 0x84, 0x3a, 0x84, 0xda, 0x54, 0xef,
-	0x9b, 0x8e, 0xa3, 0x3f, 0xbe, 0xd3, 0xb1, 0x98, 0x79, 0x47, 0x67, 0x23, 0x6d, 0x30, 0x24, 0x8c,
-	0xc8, 0x9b, 0xe2, 0x58, 0xf3, 0x8e, 0x35, 0x71, 0xac, 0x6c, 0xf6, 0x49, 0x9f, 0xf8, 0x06, 0xba,
-	0xf7, 0x1b, 0xb7, 0x55, 0x6a, 0x5d, 0xdf, 0x58, 0xef, 0x98, 0xd4, 0x0a, 0x91, 0xba, 0xc4, 0xc6,
-	0xfc, 0x1c, 0xfd, 0x5e, 0x82, 0xb5, 0x26, 0xed, 0x7f, 0x4e, 0x6c, 0xfc, 0x05, 0x21, 0x87, 0xf2,
-	0x4d, 0x58, 0xa5, 0x16, 0xee, 0x59, 0xc3, 0xaa, 0xb4, 0x2b, 0xed, 0x9d, 0xaf, 0x6f, 0x4c, 0xc6,
-	0xea, 0xfa, 0x13, 0xd3, 0x39, 0x7c, 0x1f, 0xf1, 0xcf, 0x91, 0x21, 0x0c, 0xe4, 0x5b, 0xb0, 0x3a,
-	0x20, 0xe4, 0xb0, 0xd1, 0xab, 0x96, 0x76, 0xa5, 0xbd, 0x4a, 0x5d, 0x9e, 0x8c, 0xd5, 0x0b, 0xdc,
-	0xd4, 0xfb, 0xbc, 0x6d, 0xf7, 0x90, 0x21, 0x2c, 0xe4, 0x01, 0x5c, 0xa0, 0x0f, 0xcd, 0xa1, 0xd5,
-	0x72, 0xd9, 0xc7, 0x0e, 0x71, 0x31, 0xab, 0x96, 0x7d, 0xf8, 0xcf, 0x9e, 0x8d, 0xd5, 0x95, 0x7f,
-	0xc7, 0xea, 0xeb, 0x7d, 0x9b, 0x3d, 0x74, 0x3b, 0x5a, 0x97, 0x38, 0xba, 0x88, 0x98, 0xff, 0xb8,
-	0x4d, 0x7b, 0x8f, 0x74, 0xf6, 0x64, 0x60, 0x51, 0xad, 0x81, 0xd9, 0x64, 0xac, 0x5e, 0x8a, 0x78,
-	0x30, 0x7d, 0xa8, 0x36, 0x71, 0x19, 0x32, 0x12, 0xf8, 0xf2, 0xd7, 0xb0, 0xc6, 0xc8, 0x23, 0x0b,
-	0x37, 0x70, 0xd3, 0x1c, 0xd1, 0x6a, 0x65, 0xb7, 0xbc, 0xb7, 0xb6, 0xbf, 0xa3, 0x71, 0x54, 0xcd,
-	0xa3, 0x23, 0x60, 0x4e, 0xfb, 0x84, 0xd8, 0xb8, 0x7e, 0xdd, 0x8b, 0x64, 0x32, 0x56, 0x2f, 0x73,
-	0x7c, 0xff, 0x6e, 0xdb, 0xc6, 0x6d, 0xc7, 0x1c, 0x09, 0x3f, 0x14, 0x19, 0x51, 0x48, 0xb4, 0x05,
-	0xaf, 0x45, 0x98, 0x33, 0x2c, 0x3a, 0x20, 0x98, 0x5a, 0xe8, 0x29, 0x67, 0xf4, 0x60, 0x64, 0xb3,
-	0x65, 0x32, 0x8a, 0x61, 0xdd, 0x7f, 0x71, 0x03, 0x9f, 0x0c, 0xa1, 0x3e, 0x98, 0xf7, 0x60, 0xfe,
-	0x58, 0x64, 0xc4, 0xe1, 0xe5, 0x2e, 0xbc, 0xe2, 0x3f, 0xbe, 0xe5, 0xb2, 0xa6, 0x8d, 0x17, 0x20,
-	0xf4, 0x86, 0x20, 0xf4, 0x4a, 0x94, 0x50, 0xe2, 0xb2, 0xb6, 0x13, 0x3a, 0xa1, 0xc8, 0x88, 0x81,
-	0x0a, 0x4a, 0x03, 0xea, 0x42, 0x4a, 0x7f, 0x94, 0x60, 0xe3, 0xc1, 0xb7, 0xe6, 0x80, 0x87, 0xd2,
-	0xc0, 0x06, 0x71, 0x99, 0x15, 0x61, 0x4b, 0x9a, 0xcb, 0xd6, 0x47, 0xb0, 0x1e, 0x38, 0xfa, 0xd4,
-	0xc2, 0xc4, 0xf1, 0x09, 0x3e, 0x5f, 0x57, 0xa6, 0xef, 0x9f, 0xc6, 0xd7, 0xf3, 0x0c, 0x90, 0x11,
-	0xbf, 0x80, 0xfe, 0x2a, 0xc1, 0x66, 0x93, 0xf6, 0xbd, 0x30, 0x0e, 0x46, 0x66, 0x97, 0x05, 0xb1,
-	0x14, 0xd1, 0xf7, 0x00, 0x56, 0x87, 0x5e, 0xe8, 0xb4, 0x5a, 0xf2, 0xd9, 0x7b, 0x43, 0xcb, 0xaa,
-	0x64, 0x2d, 0xf5, 0xd4, 0x7a, 0xc5, 0xe3, 0xd2, 0x10, 0x97, 0xe5, 0xfb, 0x70, 0x56, 0xe4, 0xa1,
-	0x2f, 0xfa, 0x4c, 0x15, 0xb6, 0x85, 0x0a, 0xaf, 0xc6, 0xd3, 0x1a, 0x19, 0x01, 0x84, 0xfc, 0x1d,
-	0x6c, 0x44, 0x34, 0x10, 0xc9, 0x54, 0xf1, 0x9f, 0xd2, 0x2c, 0x9c, 0x4c, 0x97, 0xf3, 0xc5, 0x46,
-	0x46, 0xda, 0x0f, 0xaa, 0xc1, 0x95, 0x2c, 0x52, 0x43, 0xe5, 0x7f, 0x90, 0x40, 0x9e, 0xd2, 0xd1,
-	0x72, 0x59, 0x71, 0xe9, 0x3f, 0x14, 0x89, 0xdb, 0xc0, 0x8b, 0x2a, 0x1f, 0xb3, 0x47, 0x7f, 0x97,
-	0x60, 0x2b, 0x1d, 0x63, 0xcb, 0x65, 0x45, 0x94, 0xbf, 0x97, 0x50, 0x7e, 0x6f, 0x9e, 0xf2, 0xc1,
-	0x53, 0x13, 0xd2, 0x8f, 0xe0, 0xe2, 0xb4, 0x05, 0xc5, 0x0a, 0xff, 0x7e, 0x61, 0xad, 0x94, 0xdc,
-	0x4e, 0x87, 0x8c, 0x94, 0x17, 0xb9, 0x05, 0xe7, 0x02, 0xf9, 0xfc, 0xec, 0x98, 0x99, 0x75, 0x55,
-	0x91, 0x75, 0x17, 0x13, 0x0c, 0x23, 0x23, 0x04, 0x41, 0x2a, 0x5c, 0xcd, 0xa4, 0x35, 0xd4, 0xfe,
-	0x8f, 0x12, 0xec, 0x88, 0x06, 0xcb, 0xad, 0x98, 0x35, 0xc4, 0x2f, 0x52, 0x76, 0x45, 0xda, 0xea,
-	0x89, 0xd7, 0x56, 0x30, 0x96, 0x4e, 0xac, 0xb6, 0x78, 0xa3, 0x4e, 0xd5, 0x56, 0xca, 0x0f, 0xba,
-	0x0e, 0xd7, 0x72, 0xe9, 0x0b, 0x49, 0xfe, 0xb5, 0x1c, 0x23, 0xf9, 0x81, 0x87, 0xf2, 0x42, 0x19,
-	0x5e, 0x84, 0xe4, 0x0f, 0x12, 0x25, 0xc9, 0x33, 0x78, 0x67, 0x32, 0x56, 0xb7, 0x12, 0x39, 0x99,
-	0x55, 0x91, 0xf2, 0x37, 0xa9, 0x65, 0x82, 0x53, 0xda, 0x28, 0x4c, 0xe9, 0x76, 0x92, 0xd2, 0x80,
-	0xce, 0xe4, 0x36, 0x91, 0x55, 0x77, 0x67, 0x4e, 0xa3, 0xee, 0x12, 0x2a, 0xc6, 0xf5, 0x09, 0x55,
-	0xfc, 0xad, 0x0c, 0x55, 0x31, 0x38, 0x13, 0x56, 0xcb, 0xab, 0x94, 0xd4, 0x48, 0x2d, 0x17, 0x1c,
-	0xa9, 0xe9, 0x15, 0xa6, 0xb2, 0xdc, 0x15, 0x26, 0x73, 0xd2, 0x9d, 0x39, 0xa5, 0x49, 0x87, 0x60,
-	0x37, 0x4f, 0xa1, 0x50, 0xc6, 0x3f, 0x4b, 0xa0, 0x44, 0x8c, 0xa2, 0x25, 0xbb, 0xc4, 0x6a, 0x8c,
-	0x76, 0xf6, 0xf2, 0x09, 0x74, 0x76, 0xaf, 0x58, 0x04, 0xf1, 0xd3, 0x62, 0xa9, 0xbc, 0x5c, 0xb1,
-	0x84, 0xd2, 0xc6, 0x8a, 0x25, 0xe9, 0x05, 0xdd, 0x00, 0x94, 0xcf, 0x5f, 0x40, 0xf3, 0xfe, 0xd3,
-	0xb3, 0x50, 0x6e, 0xd2, 0xbe, 0xfc, 0x25, 0x9c, 0x0b, 0xff, 0xef, 0xb9, 0x96, 0x3d, 0x90, 0x23,
-	0x0b, 0xbe, 0x72, 0x73, 0xae, 0x49, 0xe0, 0xc1, 0x43, 0x0e, 0xf7, 0xff, 0x7c, 0xe4, 0xc0, 0x64,
-	0x06, 0x72, 0x72, 0x15, 0x96, 0x29, 0xdf, 0x84, 0xe3, 0x2b, 0xe8, 0xad, 0xdc, 0xfb, 0x29, 0x5b,
-	0x65, 0x7f, 0x71, 0xdb, 0xd0, 0xe9, 0x63, 0xbe, 0x84, 0x25, 0xd6, 0x9f, 0x37, 0x17, 0x45, 0x6a,
-	0xb9, 0x4c, 0xb9, 0x5b, 0xc0, 0x38, 0xf4, 0xfb, 0x93, 0x04, 0x97, 0x72, 0xc6, 0xbf, 0x3e, 0x53,
-	0x8c, 0xf4, 0x05, 0xe5, 0xdd, 0x82, 0x17, 0x32, 0x83, 0x48, 0x8c, 0xc7, 0xf9, 0x41, 0xc4, 0x2f,
-	0x2c, 0x10, 0x44, 0x76, 0x83, 0x97, 0x7f, 0x96, 0x60, 0x3b, 0xaf, 0x2d, 0xbc, 0x3d, 0x33, 0x7b,
-	0x32, 0x6e, 0x28, 0xef, 0x15, 0xbd, 0x11, 0xc6, 0xf1, 0x3d, 0x6c, 0x65, 0x0f, 0x19, 0x6d, 0x2e,
-	0x64, 0xcc, 0x5e, 0x79, 0xa7, 0x98, 0x7d, 0x10, 0x40, 0xfd, 0xde, 0xb3, 0xa3, 0x9a, 0xf4, 0xfc,
-	0xa8, 0x26, 0xfd, 0x77, 0x54, 0x93, 0x7e, 0x39, 0xae, 0xad, 0x3c, 0x3f, 0xae, 0xad, 0xfc, 0x73,
-	0x5c, 0x5b, 0xf9, 0xea, 0xad, 0x48, 0x4f, 0x11, 0xd8, 0xb7, 0x0f, 0xcd, 0x0e, 0x0d, 0xfe, 0xd0,
-	0x47, 0xfc, 0xeb, 0x14, 0xbf, 0xbb, 0x74, 0x56, 0xfd, 0xaf, 0x3f, 0xee, 0xfe, 0x1f, 0x00, 0x00,
-	0xff, 0xff, 0xb8, 0x33, 0x3b, 0x9d, 0x6b, 0x11, 0x00, 0x00,
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6e, 0x1b, 0x55,
+	0x14, 0xce, 0xd8, 0x6e, 0x70, 0x4f, 0x48, 0x89, 0xa7, 0x71, 0xe3, 0x4c, 0x5a, 0x4f, 0x7a, 0x5b,
+	0x41, 0x52, 0xe8, 0x98, 0xa4, 0x12, 0x41, 0x48, 0x20, 0x30, 0x24, 0xc2, 0x28, 0x96, 0xd1, 0x94,
+	0x45, 0xc5, 0xc6, 0x8c, 0xed, 0xc1, 0x1d, 0x35, 0x9e, 0xeb, 0xfa, 0xde, 0x29, 0x8e, 0x58, 0xf0,
+	0x23, 0xb1, 0xef, 0x13, 0x20, 0xf6, 0xbc, 0x00, 0x2c, 0x60, 0xdd, 0x65, 0x25, 0x84, 0x84, 0x58,
+	0x58, 0x28, 0x79, 0x03, 0x3f, 0x00, 0x42, 0x33, 0x73, 0xe7, 0x7a, 0x7e, 0x63, 0x4f, 0x89, 0xb3,
+	0x4a, 0xec, 0xf9, 0xce, 0xcf, 0xfd, 0xbe, 0x73, 0xee, 0x39, 0x63, 0xb8, 0x81, 0x49, 0x0f, 0x13,
+	0x83, 0x54, 0xba, 0x5a, 0xaf, 0x57, 0x79, 0xb2, 0xd3, 0xd2, 0xa9, 0xb6, 0x53, 0xa1, 0x43, 0xa5,
+	0x3f, 0xc0, 0x14, 0x8b, 0xab, 0xec, 0xb1, 0x62, 0x3f, 0x56, 0xd8, 0x63, 0x69, 0xb5, 0x8b, 0xbb,
+	0xd8, 0x01, 0x54, 0xec, 0xff, 0x5c, 0xac, 0x54, 0x6e, 0x3b, 0xe0, 0x4a, 0x4b, 0x23, 0x3a, 0xf7,
+	0xd4, 0xc6, 0x86, 0xe9, 0x3e, 0x47, 0xbf, 0x64, 0x60, 0xa9, 0x4e, 0xba, 0x9f, 0x60, 0xc3, 0xfc,
+	0x14, 0xe3, 0x23, 0x71, 0x1b, 0x16, 0x89, 0x6e, 0x76, 0xf4, 0x41, 0x49, 0xd8, 0x14, 0xb6, 0x2e,
+	0x57, 0x0b, 0xe3, 0x91, 0xbc, 0x7c, 0xac, 0xf5, 0x8e, 0xde, 0x41, 0xee, 0xf7, 0x48, 0x65, 0x00,
+	0xf1, 0x0e, 0x2c, 0xf6, 0x31, 0x3e, 0xaa, 0x75, 0x4a, 0x99, 0x4d, 0x61, 0x2b, 0x57, 0x15, 0xc7,
+	0x23, 0xf9, 0x8a, 0x0b, 0xb5, 0xbf, 0x6f, 0x1a, 0x1d, 0xa4, 0x32, 0x84, 0xd8, 0x87, 0x2b, 0xe4,
+	0xa1, 0x36, 0xd0, 0x1b, 0x16, 0xfd, 0xa0, 0x87, 0x2d, 0x93, 0x96, 0xb2, 0x8e, 0xfb, 0x8f, 0x9f,
+	0x8d, 0xe4, 0x85, 0xbf, 0x47, 0xf2, 0xab, 0x5d, 0x83, 0x3e, 0xb4, 0x5a, 0x4a, 0x1b, 0xf7, 0x2a,
+	0x2c, 0x63, 0xf7, 0xcf, 0x5d, 0xd2, 0x79, 0x54, 0xa1, 0xc7, 0x7d, 0x9d, 0x28, 0x35, 0x93, 0x8e,
+	0x47, 0xf2, 0x35, 0x5f, 0x04, 0xcd, 0x71, 0xd5, 0xc4, 0x16, 0x45, 0x6a, 0xc8, 0xbf, 0xf8, 0x05,
+	0x2c, 0x51, 0xfc, 0x48, 0x37, 0x6b, 0x66, 0x5d, 0x1b, 0x92, 0x52, 0x6e, 0x33, 0xbb, 0xb5, 0xb4,
+	0xbb, 0xae, 0xb8, 0x5e, 0x15, 0x9b, 0x0e, 0x8f, 0x39, 0xe5, 0x43, 0x6c, 0x98, 0xd5, 0x5b, 0x76,
+	0x26, 0xe3, 0x91, 0xbc, 0xe1, 0xfa, 0x77, 0x6c, 0x9b, 0x86, 0xd9, 0xec, 0x69, 0x43, 0x16, 0x87,
+	0x20, 0xd5, 0xef, 0x12, 0x15, 0xe1, 0xaa, 0x8f, 0x39, 0x55, 0x27, 0x7d, 0x6c, 0x12, 0x1d, 0xfd,
+	0xea, 0x32, 0xba, 0x3f, 0x34, 0xe8, 0x3c, 0x19, 0x35, 0x61, 0xd9, 0x39, 0x71, 0xcd, 0x3c, 0x1f,
+	0x42, 0x1d, 0x67, 0xf6, 0x81, 0xdd, 0xc3, 0x22, 0x35, 0xe8, 0x5e, 0x6c, 0xc3, 0xcb, 0xce, 0xe1,
+	0x1b, 0x16, 0xad, 0x1b, 0xe6, 0x0c, 0x84, 0xde, 0x66, 0x84, 0x5e, 0xf7, 0x13, 0x8a, 0x2d, 0xda,
+	0xec, 0xf1, 0x20, 0x04, 0xa9, 0x01, 0xa7, 0x8c, 0x52, 0x8f, 0x3a, 0x4e, 0xe9, 0x77, 0x02, 0x14,
+	0xee, 0x7f, 0xa5, 0xf5, 0xdd, 0x54, 0x6a, 0xa6, 0x8a, 0x2d, 0xaa, 0xfb, 0xd8, 0x12, 0xa6, 0xb2,
+	0xf5, 0x3e, 0x2c, 0x7b, 0x81, 0x3e, 0xd2, 0x4d, 0xdc, 0x73, 0x08, 0xbe, 0x5c, 0x95, 0x26, 0xe7,
+	0x9f, 0xe4, 0xd7, 0xb1, 0x01, 0x48, 0x0d, 0x1a, 0xa0, 0x3f, 0x32, 0xb0, 0x5a, 0x27, 0x5d, 0x3b,
+	0x8d, 0xfd, 0xa1, 0xd6, 0xa6, 0x5e, 0x2e, 0x69, 0xf4, 0xdd, 0x87, 0xc5, 0x81, 0x9d, 0x3a, 0x29,
+	0x65, 0x1c, 0xf6, 0x5e, 0x53, 0xe2, 0x3a, 0x59, 0x89, 0x1c, 0xb5, 0x9a, 0xb3, 0xb9, 0x54, 0x99,
+	0xb1, 0x78, 0x08, 0x2f, 0xb1, 0x3a, 0x74, 0x44, 0x3f, 0x53, 0x85, 0x35, 0xa6, 0xc2, 0x2b, 0xc1,
+	0xb2, 0x46, 0xaa, 0xe7, 0x42, 0xfc, 0x1a, 0x0a, 0x3e, 0x0d, 0x58, 0x31, 0xe5, 0x9c, 0xa3, 0xd4,
+	0x53, 0x17, 0xd3, 0x46, 0xb2, 0xd8, 0x48, 0x8d, 0xc6, 0x41, 0x65, 0xb8, 0x1e, 0x47, 0x2a, 0x57,
+	0xfe, 0x5b, 0x01, 0xc4, 0x09, 0x1d, 0x0d, 0x8b, 0xa6, 0x97, 0xfe, 0x3d, 0x56, 0xb8, 0x35, 0x73,
+	0x56, 0xe5, 0x03, 0x78, 0xf4, 0x67, 0x06, 0x8a, 0xd1, 0x1c, 0x1b, 0x16, 0x4d, 0xa3, 0xfc, 0x41,
+	0x48, 0xf9, 0xad, 0x69, 0xca, 0x7b, 0x47, 0x0d, 0x49, 0x3f, 0x84, 0x95, 0xc9, 0x15, 0x14, 0x68,
+	0xfc, 0xc3, 0xd4, 0x5a, 0x49, 0x89, 0x37, 0x1d, 0x52, 0x23, 0x51, 0xc4, 0x06, 0xe4, 0x3d, 0xf9,
+	0x4a, 0xb9, 0x69, 0x55, 0x57, 0x62, 0x55, 0xb7, 0x12, 0x62, 0x18, 0xa9, 0xdc, 0x09, 0x92, 0xe1,
+	0x46, 0x2c, 0xad, 0x5c, 0xfb, 0xdf, 0x32, 0xb0, 0xce, 0x2e, 0x58, 0x17, 0x45, 0xf5, 0x81, 0xf9,
+	0x22, 0x6d, 0x97, 0xe6, 0x5a, 0x3d, 0xf7, 0xde, 0xf2, 0xc6, 0xd2, 0xb9, 0xf5, 0x96, 0x7b, 0x51,
+	0x47, 0x7a, 0x2b, 0x12, 0x07, 0xdd, 0x82, 0x9b, 0x89, 0xf4, 0x71, 0x92, 0x7f, 0xcc, 0x06, 0x48,
+	0xbe, 0x6f, 0x7b, 0x79, 0xa1, 0x0a, 0x4f, 0x43, 0xf2, 0xbb, 0xa1, 0x96, 0x74, 0x2b, 0x78, 0x7d,
+	0x3c, 0x92, 0x8b, 0xa1, 0x9a, 0x8c, 0xeb, 0x48, 0xf1, 0x71, 0x64, 0x99, 0x70, 0x29, 0xad, 0xa5,
+	0xa6, 0x74, 0x2d, 0x4c, 0xa9, 0x47, 0x67, 0x78, 0x9b, 0x88, 0xeb, 0xbb, 0x4b, 0x17, 0xd1, 0x77,
+	0x21, 0x15, 0x83, 0xfa, 0x70, 0x15, 0x7f, 0xca, 0x42, 0x89, 0x0d, 0xce, 0x10, 0x6a, 0x7e, 0x9d,
+	0x12, 0x19, 0xa9, 0xd9, 0x94, 0x23, 0x35, 0xba, 0xc2, 0xe4, 0xe6, 0xbb, 0xc2, 0xc4, 0x4e, 0xba,
+	0x4b, 0x17, 0x34, 0xe9, 0x10, 0x6c, 0x26, 0x29, 0xc4, 0x65, 0xfc, 0x3d, 0x03, 0x92, 0x0f, 0xe4,
+	0x6f, 0xd9, 0x39, 0x76, 0xa3, 0xff, 0x66, 0xcf, 0x9e, 0xc3, 0xcd, 0x6e, 0x37, 0x0b, 0x23, 0x7e,
+	0xd2, 0x2c, 0xb9, 0xff, 0xd7, 0x2c, 0x5c, 0xda, 0x40, 0xb3, 0x84, 0xa3, 0xa0, 0xdb, 0x80, 0x92,
+	0xf9, 0xe3, 0x34, 0xff, 0x9b, 0x75, 0xd6, 0xcc, 0xba, 0xd1, 0x1d, 0x68, 0x54, 0x3f, 0x34, 0x1e,
+	0x5b, 0x46, 0xc7, 0xa0, 0xc7, 0x69, 0xf8, 0xdd, 0x03, 0x70, 0xd9, 0x3b, 0x18, 0xb0, 0x95, 0x22,
+	0x57, 0x5d, 0x1b, 0x8f, 0xe4, 0xab, 0x01, 0x8e, 0x9b, 0x5f, 0x0e, 0xec, 0xb2, 0xf7, 0x41, 0xc5,
+	0x1d, 0xc8, 0xbb, 0x9f, 0x3e, 0xc3, 0x0e, 0xd9, 0xb9, 0x6a, 0x71, 0x3c, 0x92, 0x0b, 0x41, 0x33,
+	0x8a, 0x91, 0xca, 0x61, 0x17, 0xde, 0x26, 0xe1, 0x4d, 0xff, 0xd2, 0x1c, 0x36, 0xfd, 0xf8, 0xc9,
+	0xb8, 0x78, 0x41, 0x93, 0xf1, 0xa9, 0x00, 0x1b, 0x31, 0x05, 0xe0, 0x15, 0x48, 0xcc, 0x80, 0x11,
+	0xe6, 0x3c, 0x60, 0x76, 0x7f, 0xce, 0x43, 0xb6, 0x4e, 0xba, 0xe2, 0x03, 0xc8, 0xf3, 0x77, 0xf1,
+	0x9b, 0xf1, 0x4b, 0xa2, 0xef, 0xa5, 0x53, 0xda, 0x9e, 0x0a, 0xe1, 0x87, 0x7a, 0x00, 0x79, 0xfe,
+	0x4e, 0x9a, 0xec, 0xd9, 0x83, 0x48, 0xdb, 0x53, 0x21, 0xdc, 0x33, 0x81, 0x42, 0x68, 0x8d, 0xab,
+	0x99, 0xe2, 0x9d, 0x44, 0xfb, 0x08, 0x56, 0xda, 0x9d, 0x1d, 0xcb, 0x83, 0x3e, 0x01, 0x31, 0xf4,
+	0xd0, 0xbe, 0x7a, 0x5e, 0x9f, 0xd5, 0x53, 0xc3, 0xa2, 0xd2, 0xbd, 0x14, 0x60, 0x1e, 0xf7, 0x7b,
+	0x01, 0xae, 0x25, 0xac, 0xa4, 0x95, 0x33, 0xc5, 0x88, 0x1a, 0x48, 0x7b, 0x29, 0x0d, 0x62, 0x93,
+	0x08, 0xad, 0x6c, 0xd3, 0x93, 0x08, 0x1a, 0x48, 0x7b, 0x29, 0x0d, 0x78, 0x12, 0x3f, 0x08, 0xb0,
+	0x96, 0x34, 0xaa, 0xde, 0x3c, 0xb3, 0x7a, 0x62, 0x2c, 0xa4, 0xb7, 0xd3, 0x5a, 0xf0, 0x3c, 0xbe,
+	0x81, 0x62, 0xfc, 0xe2, 0xa3, 0x4c, 0x75, 0x19, 0xc0, 0x4b, 0x6f, 0xa5, 0xc3, 0xf3, 0x04, 0xfa,
+	0xb0, 0x12, 0x9d, 0x25, 0x89, 0xbe, 0xc2, 0x50, 0x69, 0x67, 0x66, 0xa8, 0x17, 0xb1, 0x7a, 0xf0,
+	0xec, 0xa4, 0x2c, 0x3c, 0x3f, 0x29, 0x0b, 0xff, 0x9c, 0x94, 0x85, 0xa7, 0xa7, 0xe5, 0x85, 0xe7,
+	0xa7, 0xe5, 0x85, 0xbf, 0x4e, 0xcb, 0x0b, 0x9f, 0xbf, 0xe1, 0xbb, 0x9a, 0x98, 0xdb, 0xbb, 0x47,
+	0x5a, 0x8b, 0x78, 0x1f, 0x2a, 0x43, 0xf7, 0x47, 0x45, 0xe7, 0x92, 0x6a, 0x2d, 0x3a, 0x3f, 0x02,
+	0xde, 0xfb, 0x6f, 0x00, 0x24, 0x12, 0xcb, 0xae, 0x71, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1031,6 +1148,7 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateLiquidity(ctx context.Context, in *MsgMigrateLiquidity, opts ...grpc.CallOption) (*MsgMigrateLiquidityResponse, error)
 }
 
 type msgClient struct {
@@ -1113,6 +1231,15 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) MigrateLiquidity(ctx context.Context, in *MsgMigrateLiquidity, opts ...grpc.CallOption) (*MsgMigrateLiquidityResponse, error) {
+	out := new(MsgMigrateLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/MigrateLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1123,6 +1250,7 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	MigrateLiquidity(context.Context, *MsgMigrateLiquidity) (*MsgMigrateLiquidityResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1153,6 +1281,9 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) MigrateLiquidity(ctx context.Context, req *MsgMigrateLiquidity) (*MsgMigrateLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateLiquidity not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1302,6 +1433,24 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_MigrateLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateLiquidity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/MigrateLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateLiquidity(ctx, req.(*MsgMigrateLiquidity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1338,6 +1487,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "MigrateLiquidity",
+			Handler:    _Msg_MigrateLiquidity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2077,6 +2230,113 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgMigrateLiquidity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLiquidity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLiquidity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.TokenOutMins) > 0 {
+		for iNdEx := len(m.TokenOutMins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenOutMins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolIdTo != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdTo))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolIdFrom != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdFrom))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -2374,6 +2634,46 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgMigrateLiquidity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdFrom != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdFrom))
+	}
+	if m.PoolIdTo != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdTo))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMigrateLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4424,6 +4724,312 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgMigrateLiquidity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLiquidity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLiquidity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdFrom", wireType)
+			}
+			m.PoolIdFrom = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdFrom |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdTo", wireType)
+			}
+			m.PoolIdTo = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolIdTo |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMigrateLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMigrateLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMigrateLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
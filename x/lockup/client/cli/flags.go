@@ -8,6 +8,8 @@ import (
 const (
 	FlagDuration    = "duration"
 	FlagMinDuration = "min-duration"
+	FlagAmount      = "amount"
+	FlagRolling     = "rolling"
 )
 
 // FlagSetLockTokens returns flags for LockTokens msg builder
@@ -15,6 +17,7 @@ func FlagSetLockTokens() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
 	fs.String(FlagDuration, "86400s", "The duration token to be locked. e.g. 1h, 1m, 1s, 0.1s")
+	fs.Bool(FlagRolling, false, "Lock the tokens again for the duration when they finish unlocking, until opted out with set-lock-rolling")
 	return fs
 }
 
@@ -46,6 +46,7 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdAccountLockedLongerDurationDenom(),
 		GetCmdTotalLockedByDenom(),
 		GetCmdOutputLocksJson(),
+		GetCmdParams(),
 	)
 
 	return cmd
@@ -263,7 +264,12 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTime(cmd.Context(), &types.AccountLockedPastTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -273,6 +279,7 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -306,7 +313,12 @@ $ %s query lockup account-locked-pasttime-not-unlocking <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTimeNotUnlockingOnly(cmd.Context(), &types.AccountLockedPastTimeNotUnlockingOnlyRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -316,6 +328,7 @@ $ %s query lockup account-locked-pasttime-not-unlocking <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -349,7 +362,12 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountUnlockedBeforeTime(cmd.Context(), &types.AccountUnlockedBeforeTimeRequest{Owner: args[0], Timestamp: timestamp, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -359,6 +377,7 @@ $ %s query lockup account-locked-pasttime <address> <timestamp>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -394,7 +413,12 @@ $ %s query lockup account-locked-pasttime-denom <address> <timestamp> <denom>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedPastTimeDenom(cmd.Context(), &types.AccountLockedPastTimeDenomRequest{Owner: args[0], Timestamp: timestamp, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -404,6 +428,7 @@ $ %s query lockup account-locked-pasttime-denom <address> <timestamp> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -478,7 +503,12 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDuration(cmd.Context(), &types.AccountLockedLongerDurationRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -488,6 +518,7 @@ $ %s query lockup account-locked-longer-duration <address> <duration>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -520,7 +551,12 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDurationNotUnlockingOnly(cmd.Context(), &types.AccountLockedLongerDurationNotUnlockingOnlyRequest{Owner: args[0], Duration: duration, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -530,6 +566,7 @@ $ %s query lockup account-locked-longer-duration-not-unlocking <address> <durati
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -564,7 +601,12 @@ $ %s query lockup account-locked-pasttime <address> <duration> <denom>
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom})
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AccountLockedLongerDurationDenom(cmd.Context(), &types.AccountLockedLongerDurationDenomRequest{Owner: args[0], Duration: duration, Denom: denom, Pagination: pageReq})
 			if err != nil {
 				return err
 			}
@@ -574,6 +616,7 @@ $ %s query lockup account-locked-pasttime <address> <duration> <denom>
 	}
 
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "account locks")
 
 	return cmd
 }
@@ -708,3 +751,38 @@ $ %s query lockup output-all-locks <max lock ID>
 
 	return cmd
 }
+
+// GetCmdParams returns the lockup module params
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the lockup module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the lockup module params.
+
+Example:
+$ %s query lockup params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
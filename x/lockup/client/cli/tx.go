@@ -28,6 +28,12 @@ func GetTxCmd() *cobra.Command {
 		NewLockTokensCmd(),
 		NewBeginUnlockingCmd(),
 		NewBeginUnlockByIDCmd(),
+		NewExtendLockupCmd(),
+		NewCancelUnlockByIDCmd(),
+		NewMergeLocksCmd(),
+		NewTransferLockCmd(),
+		NewForceUnlockWithPenaltyCmd(),
+		NewSetLockRollingCmd(),
 	)
 
 	return cmd
@@ -61,11 +67,17 @@ func NewLockTokensCmd() *cobra.Command {
 				return err
 			}
 
+			rolling, err := cmd.Flags().GetBool(FlagRolling)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgLockTokens(
 				clientCtx.GetFromAddress(),
 				duration,
 				coins,
 			)
+			msg.Rolling = rolling
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -125,9 +137,235 @@ func NewBeginUnlockByIDCmd() *cobra.Command {
 				return err
 			}
 
+			amountStr, err := cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(amountStr)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgBeginUnlocking(
 				clientCtx.GetFromAddress(),
 				uint64(id),
+				coins,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAmount, "", "The amount of the lock to begin unlocking, which is split off into a new lock. Unlocks the whole lock if not set.")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewExtendLockupCmd extends the duration of an individual period lock by ID
+func NewExtendLockupCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "extend-lockup [id] [duration]",
+		Short: "extend the duration of an individual period lock that is not unlocking, e.g. extend-lockup 1 336h",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			duration, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgExtendLockup(
+				clientCtx.GetFromAddress(),
+				id,
+				duration,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelUnlockByIDCmd cancels the unlocking of an individual period lock by ID
+func NewCancelUnlockByIDCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-unlock-by-id [id]",
+		Short: "cancel unlocking of an individual period lock by ID, locking it for its duration again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelUnlocking(
+				clientCtx.GetFromAddress(),
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewMergeLocksCmd merges period locks by ID into the first of them
+func NewMergeLocksCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-locks [id] [id]...",
+		Short: "merge period locks of the same denoms and duration that are not unlocking into the first of them",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			ids := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+				ids = append(ids, id)
+			}
+
+			msg := types.NewMsgMergeLocks(
+				clientCtx.GetFromAddress(),
+				ids,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewTransferLockCmd transfers a period lock by ID to a new owner
+func NewTransferLockCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-lock [id] [new-owner]",
+		Short: "transfer the ownership of a period lock by ID to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferLock(
+				clientCtx.GetFromAddress(),
+				id,
+				newOwner,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewForceUnlockWithPenaltyCmd unlocks an individual period lock by ID immediately, paying a penalty
+func NewForceUnlockWithPenaltyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "force-unlock-with-penalty [id]",
+		Short: "unlock an individual period lock by ID immediately, paying the force unlock penalty to the community pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgForceUnlockWithPenalty(
+				clientCtx.GetFromAddress(),
+				id,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewSetLockRollingCmd opts an individual period lock by ID in or out of being locked again when it finishes unlocking
+func NewSetLockRollingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-lock-rolling [id] [rolling]",
+		Short: "opt an individual period lock by ID in (true) or out (false) of being locked again for its duration when it finishes unlocking",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			rolling, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetLockRolling(
+				clientCtx.GetFromAddress(),
+				id,
+				rolling,
 			)
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
@@ -124,7 +125,12 @@ func queryAccountLockedPastTimeFn(clientCtx client.Context) http.HandlerFunc {
 			return
 		}
 
-		params := types.AccountLockedPastTimeRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0)}
+		pageReq, err := parsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.AccountLockedPastTimeRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0), Pagination: pageReq}
 
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -159,7 +165,12 @@ func queryAccountUnlockedBeforeTimeFn(clientCtx client.Context) http.HandlerFunc
 			return
 		}
 
-		params := types.AccountUnlockedBeforeTimeRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0)}
+		pageReq, err := parsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.AccountUnlockedBeforeTimeRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0), Pagination: pageReq}
 
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -196,7 +207,12 @@ func queryAccountLockedPastTimeDenomFn(clientCtx client.Context) http.HandlerFun
 
 		denom := vars[RestDenom]
 
-		params := types.AccountLockedPastTimeDenomRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0), Denom: denom}
+		pageReq, err := parsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.AccountLockedPastTimeDenomRequest{Owner: owner.String(), Timestamp: time.Unix(timestamp, 0), Denom: denom, Pagination: pageReq}
 
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -261,7 +277,12 @@ func queryAccountLockedLongerDurationFn(clientCtx client.Context) http.HandlerFu
 			return
 		}
 
-		params := types.AccountLockedLongerDurationRequest{Owner: owner.String(), Duration: duration}
+		pageReq, err := parsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.AccountLockedLongerDurationRequest{Owner: owner.String(), Duration: duration, Pagination: pageReq}
 
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -299,7 +320,12 @@ func queryAccountLockedLongerDurationDenomFn(clientCtx client.Context) http.Hand
 
 		denom := vars[RestDenom]
 
-		params := types.AccountLockedLongerDurationDenomRequest{Owner: owner.String(), Duration: duration, Denom: denom}
+		pageReq, err := parsePageRequest(r)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+
+		params := types.AccountLockedLongerDurationDenomRequest{Owner: owner.String(), Duration: duration, Denom: denom, Pagination: pageReq}
 
 		bz, err := clientCtx.LegacyAmino.MarshalJSON(params)
 		if rest.CheckBadRequestError(w, err) {
@@ -318,3 +344,25 @@ func queryAccountLockedLongerDurationDenomFn(clientCtx client.Context) http.Hand
 		rest.PostProcessResponse(w, clientCtx, res)
 	}
 }
+
+// parsePageRequest returns the page request of the page, limit and reverse args of a request
+func parsePageRequest(r *http.Request) (*query.PageRequest, error) {
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, query.DefaultLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	reverse := false
+	if strReverse := r.FormValue("reverse"); strReverse != "" {
+		reverse, err = strconv.ParseBool(strReverse)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &query.PageRequest{
+		Offset:  uint64((page - 1) * limit),
+		Limit:   uint64(limit),
+		Reverse: reverse,
+	}, nil
+}
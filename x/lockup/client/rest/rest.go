@@ -32,4 +32,5 @@ type LockTokensReq struct {
 	Owner    sdk.AccAddress `json:"owner,omitempty" yaml:"owner"`
 	Duration string         `json:"duration,omitempty" yaml:"duration"`
 	Coins    sdk.Coins      `json:"coins" yaml:"coins"`
+	Rolling  bool           `json:"rolling,omitempty" yaml:"rolling"`
 }
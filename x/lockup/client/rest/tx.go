@@ -38,6 +38,7 @@ func newLockTokensHandlerFn(clientCtx client.Context) http.HandlerFunc {
 			duration,
 			req.Coins,
 		)
+		msg.Rolling = req.Rolling
 		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
 			return
 		}
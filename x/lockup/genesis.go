@@ -9,6 +9,7 @@ import (
 // InitGenesis initializes the capability module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
 	k.SetLastLockID(ctx, genState.LastLockId)
 	if err := k.ResetAllLocks(ctx, genState.Locks); err != nil {
 		return
@@ -28,5 +29,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		LastLockId:     k.GetLastLockID(ctx),
 		Locks:          locks,
 		SyntheticLocks: k.GetAllSyntheticLockups(ctx),
+		Params:         k.GetParams(ctx),
 	}
 }
@@ -18,6 +18,7 @@ var now = time.Now().UTC()
 var acc1 = sdk.AccAddress([]byte("addr1---------------"))
 var acc2 = sdk.AccAddress([]byte("addr2---------------"))
 var testGenesis = types.GenesisState{
+	Params:     types.DefaultParams(),
 	LastLockId: 10,
 	Locks: []types.PeriodLock{
 		{
@@ -2,8 +2,16 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
+// WithHooks returns a copy of the keeper with its hooks replaced.
+func (k Keeper) WithHooks(lh types.LockupHooks) Keeper {
+	k.hooks = lh
+	return k
+}
+
 func (k Keeper) AddLockRefByKey(ctx sdk.Context, key []byte, lockID uint64) error {
 	return k.addLockRefByKey(ctx, key, lockID)
 }
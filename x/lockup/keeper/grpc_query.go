@@ -67,7 +67,11 @@ func (k Keeper) AccountLockedPastTime(goCtx context.Context, req *types.AccountL
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeResponse{Locks: k.GetAccountLockedPastTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedPastTimeRanges(ctx, owner, req.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountUnlockedBeforeTime Returns the total unlocks of an account whose unlock time is before timestamp
@@ -80,7 +84,11 @@ func (k Keeper) AccountUnlockedBeforeTime(goCtx context.Context, req *types.Acco
 		return nil, err
 	}
 
-	return &types.AccountUnlockedBeforeTimeResponse{Locks: k.GetAccountUnlockedBeforeTime(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountUnlockedBeforeTimeRanges(ctx, owner, req.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountUnlockedBeforeTimeResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeDenom is equal to GetAccountLockedPastTime but denom specific
@@ -92,7 +100,11 @@ func (k Keeper) AccountLockedPastTimeDenom(goCtx context.Context, req *types.Acc
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeDenomResponse{Locks: k.GetAccountLockedPastTimeDenom(ctx, owner, req.Denom, req.Timestamp)}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedPastTimeDenomRanges(ctx, owner, req.Denom, req.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // LockedByID Returns lock by lock ID
@@ -111,8 +123,11 @@ func (k Keeper) AccountLockedLongerDuration(goCtx context.Context, req *types.Ac
 	} else if err != nil {
 		return nil, err
 	}
-	locks := k.GetAccountLockedLongerDuration(ctx, owner, req.Duration)
-	return &types.AccountLockedLongerDurationResponse{Locks: locks}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedLongerDurationRanges(owner, req.Duration)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationDenom Returns account locked with duration longer than specified with specific denom
@@ -124,8 +139,11 @@ func (k Keeper) AccountLockedLongerDurationDenom(goCtx context.Context, req *typ
 	} else if err != nil {
 		return nil, err
 	}
-	locks := k.GetAccountLockedLongerDurationDenom(ctx, owner, req.Denom, req.Duration)
-	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedLongerDurationDenomRanges(owner, req.Denom, req.Duration)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationDenomResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedPastTimeNotUnlockingOnly Returns locked records of an account with unlock time beyond timestamp excluding tokens started unlocking
@@ -137,7 +155,11 @@ func (k Keeper) AccountLockedPastTimeNotUnlockingOnly(goCtx context.Context, req
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: k.GetAccountLockedPastTimeNotUnlockingOnly(ctx, owner, req.Timestamp)}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedPastTimeNotUnlockingOnlyRanges(ctx, owner, req.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedPastTimeNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 // AccountLockedLongerDurationNotUnlockingOnly Returns account locked records with longer duration excluding tokens started unlocking
@@ -149,10 +171,20 @@ func (k Keeper) AccountLockedLongerDurationNotUnlockingOnly(goCtx context.Contex
 	} else if err != nil {
 		return nil, err
 	}
-	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: k.GetAccountLockedLongerDurationNotUnlockingOnly(ctx, owner, req.Duration)}, nil
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, req.Pagination, accountLockedLongerDurationNotUnlockingOnlyRanges(owner, req.Duration)...)
+	if err != nil {
+		return nil, err
+	}
+	return &types.AccountLockedLongerDurationNotUnlockingOnlyResponse{Locks: locks, Pagination: pageRes}, nil
 }
 
 func (k Keeper) LockedDenom(goCtx context.Context, req *types.LockedDenomRequest) (*types.LockedDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	return &types.LockedDenomResponse{Amount: k.GetLockedDenom(ctx, req.Denom, req.Duration)}, nil
 }
+
+// Params returns lockup params
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
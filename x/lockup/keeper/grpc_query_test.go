@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 )
 
@@ -396,6 +397,57 @@ func (suite *KeeperTestSuite) TestAccountLockedLongerDuration() {
 	suite.Require().Len(res.Locks, 0)
 }
 
+func (suite *KeeperTestSuite) TestAccountLockedLongerDurationPagination() {
+	suite.SetupTest()
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+
+	// lock coins, with the last lock unlocking
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, 2*time.Second)
+	suite.BeginUnlocking(addr1)
+	suite.LockTokens(addr1, coins, 3*time.Second)
+
+	// first page of not unlocking locks, followed by unlocking locks
+	res, err := suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 2)
+	suite.Require().Equal(uint64(3), res.Locks[0].ID)
+	suite.Require().Equal(uint64(1), res.Locks[1].ID)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// next page by key
+	res, err = suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+	suite.Require().Nil(res.Pagination.NextKey)
+
+	// reverse order
+	res, err = suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(2), res.Locks[0].ID)
+
+	// next reverse page by key, from the unlocking locks to the not unlocking locks
+	res, err = suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1, Reverse: true}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Locks, 1)
+	suite.Require().Equal(uint64(1), res.Locks[0].ID)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	// both offset and key
+	_, err = suite.app.LockupKeeper.AccountLockedLongerDuration(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedLongerDurationRequest{Owner: addr1.String(), Duration: time.Second, Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Offset: 1}})
+	suite.Require().Error(err)
+
+	// paginated denom query
+	res2, err := suite.app.LockupKeeper.AccountLockedPastTimeDenom(sdk.WrapSDKContext(suite.ctx), &types.AccountLockedPastTimeDenomRequest{Owner: addr1.String(), Denom: "stake", Timestamp: suite.ctx.BlockTime(), Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	suite.Require().NoError(err)
+	suite.Require().Len(res2.Locks, 1)
+	suite.Require().NotNil(res2.Pagination.NextKey)
+}
+
 func (suite *KeeperTestSuite) TestAccountLockedLongerDurationNotUnlockingOnly() {
 	suite.SetupTest()
 	addr1 := sdk.AccAddress([]byte("addr1---------------"))
@@ -15,45 +15,68 @@ func unlockingPrefix(isUnlocking bool) []byte {
 	return types.KeyPrefixNotUnlocking
 }
 
-func (k Keeper) iteratorAfterTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+// lockRefRange is the range of lock refs from start inclusive to end exclusive
+type lockRefRange struct {
+	start []byte
+	end   []byte
+}
+
+func afterTimeRange(prefix []byte, time time.Time) lockRefRange {
 	timeKey := getTimeKey(time)
 	key := combineKeys(prefix, timeKey)
 	// If it’s unlockTime, then it should count as unlocked
 	// inclusive end bytes = key + 1, next iterator
-	return store.Iterator(storetypes.PrefixEndBytes(key), storetypes.PrefixEndBytes(prefix))
+	return lockRefRange{storetypes.PrefixEndBytes(key), storetypes.PrefixEndBytes(prefix)}
 }
 
-func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+func beforeTimeRange(prefix []byte, time time.Time) lockRefRange {
 	timeKey := getTimeKey(time)
 	key := combineKeys(prefix, timeKey)
 	// If it’s unlockTime, then it should count as unlocked
 	// inclusive end bytes = key + 1, next iterator
-	return store.Iterator(prefix, storetypes.PrefixEndBytes(key))
+	return lockRefRange{prefix, storetypes.PrefixEndBytes(key)}
 }
 
-func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+func longerDurationRange(prefix []byte, duration time.Duration) lockRefRange {
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
-	return sdk.KVStorePrefixIterator(store, key)
+	// inclusive on longer side, means >= (longer or equal)
+	return lockRefRange{key, storetypes.PrefixEndBytes(prefix)}
 }
 
-func (k Keeper) iteratorLongerDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
-	store := ctx.KVStore(k.storeKey)
+func shorterDurationRange(prefix []byte, duration time.Duration) lockRefRange {
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
-	// inclusive on longer side, means >= (longer or equal)
-	return store.Iterator(key, storetypes.PrefixEndBytes(prefix))
+	// inclusive on longer side, shorter means < (lower)
+	return lockRefRange{prefix, key}
 }
 
-func (k Keeper) iteratorShorterDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+func (k Keeper) iteratorRange(ctx sdk.Context, lockRefRange lockRefRange) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(lockRefRange.start, lockRefRange.end)
+}
+
+func (k Keeper) iteratorAfterTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
+	return k.iteratorRange(ctx, afterTimeRange(prefix, time))
+}
+
+func (k Keeper) iteratorBeforeTime(ctx sdk.Context, prefix []byte, time time.Time) sdk.Iterator {
+	return k.iteratorRange(ctx, beforeTimeRange(prefix, time))
+}
+
+func (k Keeper) iteratorDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
 	store := ctx.KVStore(k.storeKey)
 	durationKey := getDurationKey(duration)
 	key := combineKeys(prefix, durationKey)
-	// inclusive on longer side, shorter means < (lower)
-	return store.Iterator(prefix, key)
+	return sdk.KVStorePrefixIterator(store, key)
+}
+
+func (k Keeper) iteratorLongerDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+	return k.iteratorRange(ctx, longerDurationRange(prefix, duration))
+}
+
+func (k Keeper) iteratorShorterDuration(ctx sdk.Context, prefix []byte, duration time.Duration) sdk.Iterator {
+	return k.iteratorRange(ctx, shorterDurationRange(prefix, duration))
 }
 
 func (k Keeper) iterator(ctx sdk.Context, prefix []byte) sdk.Iterator {
@@ -105,14 +128,24 @@ func (k Keeper) LockIteratorDenom(ctx sdk.Context, isUnlocking bool, denom strin
 
 // AccountLockIteratorAfterTime returns the iterator to get locked coins by account
 func (k Keeper) AccountLockIteratorAfterTime(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, time time.Time) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeAfterTime(isUnlocking, addr, time))
+}
+
+// accountLockRangeAfterTime returns the range of the lock refs of locked coins by account
+func accountLockRangeAfterTime(isUnlocking bool, addr sdk.AccAddress, time time.Time) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorAfterTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountLockTimestamp, addr), time)
+	return afterTimeRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountLockTimestamp, addr), time)
 }
 
 // AccountLockIteratorBeforeTime returns the iterator to get unlockable coins by account
 func (k Keeper) AccountLockIteratorBeforeTime(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, time time.Time) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeBeforeTime(isUnlocking, addr, time))
+}
+
+// accountLockRangeBeforeTime returns the range of the lock refs of unlockable coins by account
+func accountLockRangeBeforeTime(isUnlocking bool, addr sdk.AccAddress, time time.Time) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorBeforeTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountLockTimestamp, addr), time)
+	return beforeTimeRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountLockTimestamp, addr), time)
 }
 
 // AccountLockIterator returns the iterator used for getting all locks by account
@@ -123,8 +156,13 @@ func (k Keeper) AccountLockIterator(ctx sdk.Context, isUnlocking bool, addr sdk.
 
 // AccountLockIteratorAfterTimeDenom returns the iterator to get locked coins by account and denom
 func (k Keeper) AccountLockIteratorAfterTimeDenom(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, denom string, time time.Time) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeAfterTimeDenom(isUnlocking, addr, denom, time))
+}
+
+// accountLockRangeAfterTimeDenom returns the range of the lock refs of locked coins by account and denom
+func accountLockRangeAfterTimeDenom(isUnlocking bool, addr sdk.AccAddress, denom string, time time.Time) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorAfterTime(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockTimestamp, addr, []byte(denom)), time)
+	return afterTimeRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockTimestamp, addr, []byte(denom)), time)
 }
 
 // AccountLockIteratorBeforeTimeDenom returns the iterator to get unlockable coins by account and denom
@@ -141,20 +179,35 @@ func (k Keeper) AccountLockIteratorDenom(ctx sdk.Context, isUnlocking bool, addr
 
 // AccountLockIteratorLongerDuration returns iterator used for getting all locks by account longer than duration
 func (k Keeper) AccountLockIteratorLongerDuration(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, duration time.Duration) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeLongerDuration(isUnlocking, addr, duration))
+}
+
+// accountLockRangeLongerDuration returns the range of the lock refs of all locks by account longer than duration
+func accountLockRangeLongerDuration(isUnlocking bool, addr sdk.AccAddress, duration time.Duration) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorLongerDuration(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountLockDuration, addr), duration)
+	return longerDurationRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountLockDuration, addr), duration)
 }
 
 // AccountLockIteratorShorterThanDuration returns iterator used for getting all locks by account longer than duration
 func (k Keeper) AccountLockIteratorShorterThanDuration(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, duration time.Duration) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeShorterThanDuration(isUnlocking, addr, duration))
+}
+
+// accountLockRangeShorterThanDuration returns the range of the lock refs of all locks by account shorter than duration
+func accountLockRangeShorterThanDuration(isUnlocking bool, addr sdk.AccAddress, duration time.Duration) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorShorterDuration(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountLockDuration, addr), duration)
+	return shorterDurationRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountLockDuration, addr), duration)
 }
 
 // AccountLockIteratorLongerDurationDenom returns iterator used for getting all locks by account and denom longer than duration
 func (k Keeper) AccountLockIteratorLongerDurationDenom(ctx sdk.Context, isUnlocking bool, addr sdk.AccAddress, denom string, duration time.Duration) sdk.Iterator {
+	return k.iteratorRange(ctx, accountLockRangeLongerDurationDenom(isUnlocking, addr, denom, duration))
+}
+
+// accountLockRangeLongerDurationDenom returns the range of the lock refs of all locks by account and denom longer than duration
+func accountLockRangeLongerDurationDenom(isUnlocking bool, addr sdk.AccAddress, denom string, duration time.Duration) lockRefRange {
 	unlockingPrefix := unlockingPrefix(isUnlocking)
-	return k.iteratorLongerDuration(ctx, combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockDuration, addr, []byte(denom)), duration)
+	return longerDurationRange(combineKeys(unlockingPrefix, types.KeyPrefixAccountDenomLockDuration, addr, []byte(denom)), duration)
 }
 
 // AccountLockIteratorDurationDenom returns iterator used for getting all locks by account and denom with specific duration
@@ -8,8 +8,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/gogo/protobuf/proto"
 	"github.com/osmosis-labs/osmosis/store"
+	"github.com/osmosis-labs/osmosis/x/gamm/utils"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 	db "github.com/tendermint/tm-db"
 )
@@ -28,6 +30,25 @@ func (k Keeper) getLocksFromIterator(ctx sdk.Context, iterator db.Iterator) []ty
 	return locks
 }
 
+// getPaginatedLocksFromRanges returns the page of the locks referenced by the lock ref ranges, selected by
+// the page request. Locks are in the order of the ranges, and in the reverse order when requested.
+func (k Keeper) getPaginatedLocksFromRanges(ctx sdk.Context, pageReq *query.PageRequest, lockRefRanges ...lockRefRange) ([]types.PeriodLock, *query.PageResponse, error) {
+	locks := []types.PeriodLock{}
+	store := newLockRefStore(ctx.KVStore(k.storeKey), lockRefRanges...)
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, value []byte) error {
+		lock, err := k.GetLockByID(ctx, sdk.BigEndianToUint64(value))
+		if err != nil {
+			return err
+		}
+		locks = append(locks, *lock)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return locks, pageRes, nil
+}
+
 func (k Keeper) beginUnlockFromIterator(ctx sdk.Context, iterator db.Iterator) ([]types.PeriodLock, sdk.Coins, error) {
 	// Note: this function is only used for an account
 	// and this has no conflicts with synthetic lockups
@@ -46,7 +67,7 @@ func (k Keeper) beginUnlockFromIterator(ctx sdk.Context, iterator db.Iterator) (
 }
 
 // WithdrawAllMaturedLocks withdraws every lock thats in the process of unlocking, and has finished unlocking by
-// the current block time.
+// the current block time. Rolling locks are locked again for their duration instead.
 func (k Keeper) WithdrawAllMaturedLocks(ctx sdk.Context) {
 	k.unlockFromIterator(ctx, k.LockIteratorBeforeTime(ctx, true, ctx.BlockTime()))
 }
@@ -109,16 +130,36 @@ func (k Keeper) unlockFromIterator(ctx sdk.Context, iterator db.Iterator) ([]typ
 	// and this has no conflicts with synthetic lockups
 
 	coins := sdk.Coins{}
+	unlockedLocks := []types.PeriodLock{}
 	locks := k.getLocksFromIterator(ctx, iterator)
 	for _, lock := range locks {
+		if lock.Rolling {
+			// relock in a cache context, so that a lock failing to relock is unlocked as usual instead
+			cacheCtx, write := ctx.CacheContext()
+			err := k.relock(cacheCtx, lock)
+			if err == nil {
+				write()
+				ctx.EventManager().EmitEvent(sdk.NewEvent(
+					types.TypeEvtRelock,
+					sdk.NewAttribute(types.AttributePeriodLockID, utils.Uint64ToString(lock.ID)),
+					sdk.NewAttribute(types.AttributePeriodLockOwner, lock.Owner),
+					sdk.NewAttribute(types.AttributePeriodLockAmount, lock.Coins.String()),
+					sdk.NewAttribute(types.AttributePeriodLockDuration, lock.Duration.String()),
+				))
+				continue
+			}
+			k.Logger(ctx).Error(fmt.Sprintf("failed to relock rolling lock %d, unlocking it instead: %s", lock.ID, err))
+		}
+
 		err := k.Unlock(ctx, lock)
 		if err != nil {
 			panic(err)
 		}
 		// sum up all coins unlocked
+		unlockedLocks = append(unlockedLocks, lock)
 		coins = coins.Add(lock.Coins...)
 	}
-	return locks, coins
+	return unlockedLocks, coins
 }
 
 func (k Keeper) getCoinsFromLocks(locks []types.PeriodLock) sdk.Coins {
@@ -241,6 +282,70 @@ func (k Keeper) GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.Ac
 	return combineLocks(notUnlockings, unlockings)
 }
 
+// accountLockedPastTimeRanges returns the lock ref ranges of GetAccountLockedPastTime
+func accountLockedPastTimeRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	duration := time.Duration(0)
+	if timestamp.After(ctx.BlockTime()) {
+		duration = timestamp.Sub(ctx.BlockTime())
+	}
+	return []lockRefRange{
+		accountLockRangeLongerDuration(false, addr, duration),
+		accountLockRangeAfterTime(true, addr, timestamp),
+	}
+}
+
+// accountLockedPastTimeNotUnlockingOnlyRanges returns the lock ref ranges of GetAccountLockedPastTimeNotUnlockingOnly
+func accountLockedPastTimeNotUnlockingOnlyRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	duration := time.Duration(0)
+	if timestamp.After(ctx.BlockTime()) {
+		duration = timestamp.Sub(ctx.BlockTime())
+	}
+	return []lockRefRange{accountLockRangeLongerDuration(false, addr, duration)}
+}
+
+// accountUnlockedBeforeTimeRanges returns the lock ref ranges of GetAccountUnlockedBeforeTime
+func accountUnlockedBeforeTimeRanges(ctx sdk.Context, addr sdk.AccAddress, timestamp time.Time) []lockRefRange {
+	unlockings := accountLockRangeBeforeTime(true, addr, timestamp)
+	if timestamp.Before(ctx.BlockTime()) {
+		return []lockRefRange{unlockings}
+	}
+	duration := timestamp.Sub(ctx.BlockTime())
+	return []lockRefRange{accountLockRangeShorterThanDuration(false, addr, duration), unlockings}
+}
+
+// accountLockedPastTimeDenomRanges returns the lock ref ranges of GetAccountLockedPastTimeDenom
+func accountLockedPastTimeDenomRanges(ctx sdk.Context, addr sdk.AccAddress, denom string, timestamp time.Time) []lockRefRange {
+	duration := time.Duration(0)
+	if timestamp.After(ctx.BlockTime()) {
+		duration = timestamp.Sub(ctx.BlockTime())
+	}
+	return []lockRefRange{
+		accountLockRangeLongerDurationDenom(false, addr, denom, duration),
+		accountLockRangeAfterTimeDenom(true, addr, denom, timestamp),
+	}
+}
+
+// accountLockedLongerDurationRanges returns the lock ref ranges of GetAccountLockedLongerDuration
+func accountLockedLongerDurationRanges(addr sdk.AccAddress, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		accountLockRangeLongerDuration(false, addr, duration),
+		accountLockRangeLongerDuration(true, addr, duration),
+	}
+}
+
+// accountLockedLongerDurationNotUnlockingOnlyRanges returns the lock ref ranges of GetAccountLockedLongerDurationNotUnlockingOnly
+func accountLockedLongerDurationNotUnlockingOnlyRanges(addr sdk.AccAddress, duration time.Duration) []lockRefRange {
+	return []lockRefRange{accountLockRangeLongerDuration(false, addr, duration)}
+}
+
+// accountLockedLongerDurationDenomRanges returns the lock ref ranges of GetAccountLockedLongerDurationDenom
+func accountLockedLongerDurationDenomRanges(addr sdk.AccAddress, denom string, duration time.Duration) []lockRefRange {
+	return []lockRefRange{
+		accountLockRangeLongerDurationDenom(false, addr, denom, duration),
+		accountLockRangeLongerDurationDenom(true, addr, denom, duration),
+	}
+}
+
 // GetLocksPastTimeDenom Returns the locks whose unlock time is beyond timestamp
 func (k Keeper) GetLocksPastTimeDenom(ctx sdk.Context, denom string, timestamp time.Time) []types.PeriodLock {
 	// returns both unlocking started and not started assuming it started unlocking current time
@@ -325,6 +430,89 @@ func (k Keeper) BeginUnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*type
 	return lock, err
 }
 
+// BeginPartialUnlockPeriodLockByID begins unlocking the given coins of the period lock with the given ID,
+// see BeginPartialUnlock. Returns the unlocking lock.
+func (k Keeper) BeginPartialUnlockPeriodLockByID(ctx sdk.Context, lockID uint64, coins sdk.Coins) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return lock, err
+	}
+	unlockingLock, err := k.BeginPartialUnlock(ctx, *lock, coins)
+	return &unlockingLock, err
+}
+
+// BeginPartialUnlock begins unlocking the given coins of a lock that is not unlocking,
+// by splitting them off into a new lock that begins unlocking, while the remainder stays locked.
+// The whole lock begins unlocking if no coins or all of its coins are given.
+// Returns the unlocking lock.
+func (k Keeper) BeginPartialUnlock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
+	unlockingLock := lock
+	// split unless all of the lock's coins are given. Note: Coins.IsEqual panics on different denoms
+	if !coins.Empty() && !(coins.IsAllGTE(lock.Coins) && lock.Coins.IsAllGTE(coins)) {
+		var err error
+		unlockingLock, err = k.splitLock(ctx, lock, coins)
+		if err != nil {
+			return types.PeriodLock{}, err
+		}
+	}
+
+	err := k.BeginUnlock(ctx, unlockingLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// read back the lock with the end time set by BeginUnlock
+	storedLock, err := k.GetLockByID(ctx, unlockingLock.ID)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	return *storedLock, nil
+}
+
+// splitLock moves the given coins of a lock that is not unlocking into a new lock of the same owner and duration.
+// Note: the accumulation store is left as is, as the coins stay locked for the same duration
+func (k Keeper) splitLock(ctx sdk.Context, lock types.PeriodLock, coins sdk.Coins) (types.PeriodLock, error) {
+	if lock.IsUnlocking() {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrLockUnlocking, "cannot split lock %d", lock.ID)
+	}
+	if !lock.Coins.IsAllGTE(coins) {
+		return types.PeriodLock{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "cannot split %s off lock %d of %s", coins, lock.ID, lock.Coins)
+	}
+	// Note: synthetic lockups have the coins of the whole lock
+	if len(k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) > 0 {
+		return types.PeriodLock{}, sdkerrors.Wrapf(types.ErrPartialUnlockSyntheticLock, "lock %d", lock.ID)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	// lock refs are keyed by denom, which the remaining coins may no longer have
+	err = k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	lock.Coins = lock.Coins.Sub(coins)
+	err = k.setLockAndResetLockRefs(ctx, lock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+
+	splitLock := types.NewPeriodLock(k.GetLastLockID(ctx)+1, owner, lock.Duration, time.Time{}, coins)
+	splitLock.Rolling = lock.Rolling
+	err = k.setLockAndResetLockRefs(ctx, splitLock)
+	if err != nil {
+		return types.PeriodLock{}, err
+	}
+	k.SetLastLockID(ctx, splitLock.ID)
+
+	if k.hooks != nil {
+		k.hooks.OnLockSplit(ctx, owner, lock.ID, splitLock.ID, coins)
+	}
+	return splitLock, nil
+}
+
 // UnlockPeriodLockByID unlock by period lock ID
 func (k Keeper) UnlockPeriodLockByID(ctx sdk.Context, LockID uint64) (*types.PeriodLock, error) {
 	lock, err := k.GetLockByID(ctx, LockID)
@@ -457,6 +645,143 @@ func (k Keeper) SlashTokensFromLockByID(ctx sdk.Context, lockID uint64, coins sd
 	return lock, nil
 }
 
+// ExtendLockup extends the duration of a lock that is not unlocking, moving its lock refs
+// and its accumulation store entries, as well as those of its synthetic lockups, to the new duration.
+func (k Keeper) ExtendLockup(ctx sdk.Context, lock types.PeriodLock, newDuration time.Duration) error {
+	if lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrLockUnlocking, "cannot extend lock %d", lock.ID)
+	}
+	if newDuration <= lock.Duration {
+		return sdkerrors.Wrapf(types.ErrInvalidLockDuration, "new duration (%s) should be longer than the current one (%s)", newDuration, lock.Duration)
+	}
+
+	err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+	if err != nil {
+		return err
+	}
+
+	// Note: synthetic lockups are accumulated at the native lock's duration
+	coins := lock.Coins
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		coins = coins.Add(syntheticCoins(lock.Coins, synthLock.Suffix)...)
+	}
+	for _, coin := range coins {
+		k.accumulationStore(ctx, coin.Denom).Decrease(accumulationKey(lock.Duration), coin.Amount)
+		k.accumulationStore(ctx, coin.Denom).Increase(accumulationKey(newDuration), coin.Amount)
+	}
+
+	previousDuration := lock.Duration
+	lock.Duration = newDuration
+	err = k.setLock(ctx, lock)
+	if err != nil {
+		return err
+	}
+
+	err = k.addLockRefs(ctx, types.KeyPrefixNotUnlocking, lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks == nil {
+		return nil
+	}
+
+	k.hooks.OnLockupExtend(ctx, lock.ID, previousDuration, newDuration)
+	return nil
+}
+
+// ExtendLockupByID extends the duration of the owner's lock with the given ID, see ExtendLockup.
+func (k Keeper) ExtendLockupByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, newDuration time.Duration) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	err = k.ExtendLockup(ctx, *lock, newDuration)
+	if err != nil {
+		return nil, err
+	}
+	lock.Duration = newDuration
+	return lock, nil
+}
+
+// MergeLocks merges the owner's locks with the given IDs into the first of them, deleting the others.
+// The locks should not be unlocking, nor have synthetic lockups, and should have the same denoms and duration.
+// Note: the accumulation store is left as is, as the coins stay locked for the same duration
+func (k Keeper) MergeLocks(ctx sdk.Context, owner sdk.AccAddress, lockIDs []uint64) (*types.PeriodLock, error) {
+	if len(lockIDs) < 2 {
+		return nil, fmt.Errorf("at least 2 locks should be merged, got %d", len(lockIDs))
+	}
+
+	locks := make([]types.PeriodLock, 0, len(lockIDs))
+	seenLockIDs := make(map[uint64]bool, len(lockIDs))
+	for _, lockID := range lockIDs {
+		if seenLockIDs[lockID] {
+			return nil, fmt.Errorf("duplicate lock ID %d", lockID)
+		}
+		seenLockIDs[lockID] = true
+
+		lock, err := k.GetLockByID(ctx, lockID)
+		if err != nil {
+			return nil, err
+		}
+		if lock.Owner != owner.String() {
+			return nil, types.ErrNotLockOwner
+		}
+		if lock.IsUnlocking() {
+			return nil, sdkerrors.Wrapf(types.ErrLockUnlocking, "cannot merge lock %d", lock.ID)
+		}
+		if len(k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) > 0 {
+			return nil, sdkerrors.Wrapf(types.ErrMergeSyntheticLock, "lock %d", lock.ID)
+		}
+		if len(locks) > 0 && !isMergeableLock(locks[0], *lock) {
+			return nil, sdkerrors.Wrapf(types.ErrMergeMismatchedLocks, "lock %d and lock %d", locks[0].ID, lock.ID)
+		}
+		locks = append(locks, *lock)
+	}
+
+	lock := locks[0]
+	mergedCoins := sdk.Coins{}
+	store := ctx.KVStore(k.storeKey)
+	for _, mergedLock := range locks[1:] {
+		err := k.deleteLockRefs(ctx, types.KeyPrefixNotUnlocking, mergedLock)
+		if err != nil {
+			return nil, err
+		}
+		store.Delete(lockStoreKey(mergedLock.ID))
+		mergedCoins = mergedCoins.Add(mergedLock.Coins...)
+	}
+
+	// lock refs of the first lock are left as is, as its denoms and duration don't change
+	lock.Coins = lock.Coins.Add(mergedCoins...)
+	err := k.setLock(ctx, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnLocksMerged(ctx, owner, lock.ID, lockIDs[1:], mergedCoins)
+	}
+	return &lock, nil
+}
+
+// isMergeableLock returns if the locks have the same owner, denoms, duration and rolling flag.
+func isMergeableLock(lock, other types.PeriodLock) bool {
+	if lock.Owner != other.Owner || lock.Duration != other.Duration || lock.Rolling != other.Rolling || len(lock.Coins) != len(other.Coins) {
+		return false
+	}
+	// coins are sorted by denom
+	for i := range lock.Coins {
+		if lock.Coins[i].Denom != other.Coins[i].Denom {
+			return false
+		}
+	}
+	return true
+}
+
 // LockTokens lock tokens from an account for specified duration
 func (k Keeper) LockTokens(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (types.PeriodLock, error) {
 	ID := k.GetLastLockID(ctx) + 1
@@ -723,6 +1048,158 @@ func (k Keeper) BeginUnlock(ctx sdk.Context, lock types.PeriodLock) error {
 	return nil
 }
 
+// CancelUnlock returns a lock that has not finished unlocking back to the NotUnlocking queue,
+// locked for its duration again.
+// Note: the accumulation store is left as is, as it accumulates locks by duration whether unlocking or not
+func (k Keeper) CancelUnlock(ctx sdk.Context, lock types.PeriodLock) error {
+	if !lock.IsUnlocking() {
+		return sdkerrors.Wrapf(types.ErrLockNotUnlocking, "lock %d", lock.ID)
+	}
+	if !ctx.BlockTime().Before(lock.EndTime) {
+		return fmt.Errorf("lock %d has already finished unlocking: %s >= %s", lock.ID, ctx.BlockTime(), lock.EndTime)
+	}
+	// Note: superfluid undelegation leaves an unbonding synthetic lockup, which can't be cancelled
+	if len(k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) > 0 {
+		return sdkerrors.Wrapf(types.ErrCancelUnlockSyntheticLock, "lock %d", lock.ID)
+	}
+
+	return k.relock(ctx, lock)
+}
+
+// relock returns an unlocking lock to the NotUnlocking queue, locked for its duration again,
+// and runs the OnCancelUnlock hook. This is done when cancelling an unlock, and when a rolling lock
+// finishes unlocking. Synthetic lockups of the lock, e.g. an unbonding superfluid undelegation,
+// are left as is, and still mature at their own end time.
+func (k Keeper) relock(ctx sdk.Context, lock types.PeriodLock) error {
+	lockOwner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+
+	// remove lock refs from unlocking queue
+	err = k.deleteLockRefs(ctx, types.KeyPrefixUnlocking, lock)
+	if err != nil {
+		return err
+	}
+
+	// store lock with end time unset, and add lock refs into not unlocking queue
+	lock.EndTime = time.Time{}
+	err = k.setLockAndResetLockRefs(ctx, lock)
+	if err != nil {
+		return err
+	}
+
+	if k.hooks != nil {
+		k.hooks.OnCancelUnlock(ctx, lockOwner, lock.ID, lock.Coins, lock.Duration)
+	}
+	return nil
+}
+
+// SetLockRolling sets whether the lock is rolling, i.e. locked again for its duration when it finishes unlocking.
+func (k Keeper) SetLockRolling(ctx sdk.Context, lock types.PeriodLock, rolling bool) error {
+	// lock refs are left as is, as they are not keyed by the rolling flag
+	lock.Rolling = rolling
+	return k.setLock(ctx, lock)
+}
+
+// SetLockRollingByID sets whether the owner's lock with the given ID is rolling, see SetLockRolling.
+func (k Keeper) SetLockRollingByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, rolling bool) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	err = k.SetLockRolling(ctx, *lock, rolling)
+	if err != nil {
+		return nil, err
+	}
+	lock.Rolling = rolling
+	return lock, nil
+}
+
+// CancelUnlockPeriodLockByID cancels the unlocking of the owner's lock with the given ID, see CancelUnlock.
+func (k Keeper) CancelUnlockPeriodLockByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	err = k.CancelUnlock(ctx, *lock)
+	if err != nil {
+		return nil, err
+	}
+	lock.EndTime = time.Time{}
+	return lock, nil
+}
+
+// TransferLock transfers the ownership of a lock and its synthetic lockups to the new owner.
+// Lockup hooks can reject the transfer, e.g. superfluid rejects transfers of superfluid delegated locks.
+// Note: the accumulation store is left as is, as it isn't keyed by owner
+func (k Keeper) TransferLock(ctx sdk.Context, lock types.PeriodLock, newOwner sdk.AccAddress) error {
+	previousOwner, err := sdk.AccAddressFromBech32(lock.Owner)
+	if err != nil {
+		return err
+	}
+	if previousOwner.Equals(newOwner) {
+		return fmt.Errorf("lock %d is already owned by %s", lock.ID, newOwner)
+	}
+
+	if k.hooks != nil {
+		err = k.hooks.BeforeLockTransfer(ctx, lock.ID, previousOwner, newOwner)
+		if err != nil {
+			return err
+		}
+	}
+
+	// move lock refs keyed by owner to the new owner
+	err = k.deleteLockRefs(ctx, unlockingPrefix(lock.IsUnlocking()), lock)
+	if err != nil {
+		return err
+	}
+	lock.Owner = newOwner.String()
+	err = k.setLockAndResetLockRefs(ctx, lock)
+	if err != nil {
+		return err
+	}
+
+	for _, synthLock := range k.GetAllSyntheticLockupsByLockup(ctx, lock.ID) {
+		err = k.deleteSyntheticLockRefs(ctx, unlockingPrefix(synthLock.IsUnlocking()), synthLock)
+		if err != nil {
+			return err
+		}
+		synthLock.Owner = newOwner.String()
+		err = k.setSyntheticLockAndResetRefs(ctx, synthLock)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TransferLockByID transfers the owner's lock with the given ID to the new owner, see TransferLock.
+func (k Keeper) TransferLockByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64, newOwner sdk.AccAddress) (*types.PeriodLock, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, types.ErrNotLockOwner
+	}
+
+	err = k.TransferLock(ctx, *lock, newOwner)
+	if err != nil {
+		return nil, err
+	}
+	lock.Owner = newOwner.String()
+	return lock, nil
+}
+
 // Unlock is a utility to unlock coins from module account
 func (k Keeper) Unlock(ctx sdk.Context, lock types.PeriodLock) error {
 	// validation for current time and unlock time
@@ -777,6 +1254,86 @@ func (k Keeper) ForceUnlock(ctx sdk.Context, lock types.PeriodLock) error {
 		if err != nil {
 			return err
 		}
+		// get the lock with its end time set, for its refs in the unlocking queue to be deleted
+		unlockingLock, err := k.GetLockByID(ctx, lock.ID)
+		if err != nil {
+			return err
+		}
+		lock = *unlockingLock
 	}
 	return k.unlock(ctx, lock)
 }
+
+// ForceUnlockWithPenalty immediately unlocks a lock, and pays the force unlock penalty out of its coins to the community pool.
+// The penalty is scaled by the fraction of the lock duration that remains, so that unlocking locks pay less as they near the end.
+// Locks with synthetic lockups, i.e. superfluid delegated or undelegating locks, can't be force unlocked.
+func (k Keeper) ForceUnlockWithPenalty(ctx sdk.Context, lock types.PeriodLock) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.ForceUnlockEnabled {
+		return nil, types.ErrForceUnlockDisabled
+	}
+	if len(k.GetAllSyntheticLockupsByLockup(ctx, lock.ID)) > 0 {
+		return nil, sdkerrors.Wrapf(types.ErrForceUnlockSyntheticLock, "lock %d", lock.ID)
+	}
+
+	penalty := k.ForceUnlockPenalty(ctx, params, lock)
+
+	err := k.ForceUnlock(ctx, lock)
+	if err != nil {
+		return nil, err
+	}
+
+	if !penalty.IsZero() {
+		owner, err := sdk.AccAddressFromBech32(lock.Owner)
+		if err != nil {
+			return nil, err
+		}
+		err = k.dk.FundCommunityPool(ctx, penalty, owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return penalty, nil
+}
+
+// ForceUnlockWithPenaltyByID force unlocks the owner's lock with the given ID, see ForceUnlockWithPenalty.
+func (k Keeper) ForceUnlockWithPenaltyByID(ctx sdk.Context, owner sdk.AccAddress, lockID uint64) (*types.PeriodLock, sdk.Coins, error) {
+	lock, err := k.GetLockByID(ctx, lockID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if lock.Owner != owner.String() {
+		return nil, nil, types.ErrNotLockOwner
+	}
+
+	penalty, err := k.ForceUnlockWithPenalty(ctx, *lock)
+	if err != nil {
+		return nil, nil, err
+	}
+	return lock, penalty, nil
+}
+
+// ForceUnlockPenalty returns the penalty to force unlock the lock,
+// the force unlock penalty fraction of its coins scaled by the fraction of its duration that remains, rounded up.
+func (k Keeper) ForceUnlockPenalty(ctx sdk.Context, params types.Params, lock types.PeriodLock) sdk.Coins {
+	remaining := lock.Duration
+	if lock.IsUnlocking() {
+		remaining = lock.EndTime.Sub(ctx.BlockTime())
+	}
+	if remaining <= 0 || lock.Duration <= 0 {
+		return sdk.Coins{}
+	}
+	if remaining > lock.Duration {
+		remaining = lock.Duration
+	}
+
+	rate := params.ForceUnlockPenalty.MulInt64(int64(remaining)).QuoInt64(int64(lock.Duration))
+	penalty := sdk.Coins{}
+	for _, coin := range lock.Coins {
+		amount := coin.Amount.ToDec().Mul(rate).Ceil().TruncateInt()
+		if amount.IsPositive() {
+			penalty = penalty.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return penalty
+}
@@ -75,6 +75,142 @@ func (suite *KeeperTestSuite) TestBeginUnlockPeriodLock() {
 	suite.Require().NotEqual(locks[0].IsUnlocking(), false)
 }
 
+func (suite *KeeperTestSuite) TestBeginPartialUnlockPeriodLock() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// try to unlock more coins than locked, or coins not locked
+	_, err := suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 11)})
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("bar", 1)})
+	suite.Require().Error(err)
+
+	// begin unlocking some of the coins
+	unlockingLock, err := suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), unlockingLock.ID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, unlockingLock.Coins)
+	suite.Require().True(unlockingLock.IsUnlocking())
+
+	// check the remainder stays locked
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("foo", 5), sdk.NewInt64Coin("stake", 6)}, lock.Coins)
+	suite.Require().False(lock.IsUnlocking())
+	suite.Require().Equal(uint64(2), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+
+	// check lock refs and accumulation store
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(1), locks[0].ID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 4)}, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal("10", accum.String())
+
+	// begin unlocking all of a denom, which removes the lock refs of the denom
+	unlockingLock, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("foo", 5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), unlockingLock.ID)
+	locks = suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "foo", time.Second)
+	suite.Require().Len(locks, 0)
+
+	// begin unlocking all of the remaining coins, which unlocks the lock itself
+	unlockingLock, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 1, sdk.Coins{sdk.NewInt64Coin("stake", 6)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), unlockingLock.ID)
+	suite.Require().Equal(uint64(3), suite.app.LockupKeeper.GetLastLockID(suite.ctx))
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+
+	// try to partially unlock a lock with synthetic lockups
+	suite.LockTokens(addr1, coins, time.Second)
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 4, "suffix", time.Second, false)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.BeginPartialUnlockPeriodLockByID(suite.ctx, 4, sdk.Coins{sdk.NewInt64Coin("stake", 4)})
+	suite.Require().Error(err)
+}
+
+// cancelUnlockHooks records the lock IDs OnCancelUnlock was run for.
+type cancelUnlockHooks struct {
+	types.MultiLockupHooks
+	lockIDs []uint64
+}
+
+func (h *cancelUnlockHooks) OnCancelUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration) {
+	h.lockIDs = append(h.lockIDs, lockID)
+}
+
+func (suite *KeeperTestSuite) TestCancelUnlockPeriodLock() {
+	suite.SetupTest()
+	hooks := &cancelUnlockHooks{}
+	lockupKeeper := suite.app.LockupKeeper.WithHooks(hooks)
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// try to cancel unlocking of a lock that is not unlocking
+	_, err := lockupKeeper.CancelUnlockPeriodLockByID(suite.ctx, addr1, 1)
+	suite.Require().Error(err)
+
+	// begin unlock
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	// try to cancel unlocking of a lock that is owned by others
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	_, err = lockupKeeper.CancelUnlockPeriodLockByID(suite.ctx, addr2, 1)
+	suite.Require().Error(err)
+
+	// try to cancel unlocking of a lock that has finished unlocking
+	_, err = lockupKeeper.CancelUnlockPeriodLockByID(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second)), addr1, 1)
+	suite.Require().Error(err)
+	suite.Require().Empty(hooks.lockIDs)
+
+	// cancel unlocking, which runs the OnCancelUnlock hook
+	lock, err := lockupKeeper.CancelUnlockPeriodLockByID(suite.ctx, addr1, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.IsUnlocking())
+	suite.Require().Equal([]uint64{1}, hooks.lockIDs)
+
+	// check lock is back in the not unlocking queue with its duration
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.IsUnlocking())
+	suite.Require().Equal(time.Second, lock.Duration)
+	suite.Require().Equal(sdk.Coins{}, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1))
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 1)
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal("10", accum.String())
+
+	// lock is not withdrawn once its former unlock time passes
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second)))
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+
+	// try to cancel unlocking of a lock with an unbonding synthetic lockup
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "suffix", time.Second, true)
+	suite.Require().NoError(err)
+	_, err = lockupKeeper.CancelUnlockPeriodLockByID(suite.ctx, addr1, 1)
+	suite.Require().Error(err)
+	suite.Require().Equal([]uint64{1}, hooks.lockIDs)
+}
+
 func (suite *KeeperTestSuite) TestGetPeriodLocks() {
 	suite.SetupTest()
 
@@ -308,6 +444,318 @@ func (suite *KeeperTestSuite) TestAddTokensToLock() {
 	suite.Require().Error(err)
 }
 
+func (suite *KeeperTestSuite) TestExtendLockup() {
+	suite.SetupTest()
+
+	// lock coins
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	suite.LockTokens(addr1, coins, time.Second)
+
+	// create synthetic lockup, accumulated at the native lock's duration
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "suffix", time.Second, false)
+	suite.Require().NoError(err)
+
+	// try to extend lock to a shorter or equal duration
+	_, err = suite.app.LockupKeeper.ExtendLockupByID(suite.ctx, addr1, 1, time.Second)
+	suite.Require().Error(err)
+
+	// try to extend lock that is owned by others
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	_, err = suite.app.LockupKeeper.ExtendLockupByID(suite.ctx, addr2, 1, time.Second*2)
+	suite.Require().Error(err)
+
+	// try to extend unavailable lock
+	_, err = suite.app.LockupKeeper.ExtendLockupByID(suite.ctx, addr1, 1111, time.Second*2)
+	suite.Require().Error(err)
+
+	// extend lock
+	lock, err := suite.app.LockupKeeper.ExtendLockupByID(suite.ctx, addr1, 1, time.Second*2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second*2, lock.Duration)
+
+	// check lock is stored with new duration and lock refs are updated
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(time.Second*2, lock.Duration)
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 0)
+	locks = suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second*2)
+	suite.Require().Len(locks, 1)
+	locks = suite.app.LockupKeeper.GetLocksLongerThanDurationDenom(suite.ctx, "stake", time.Second*2)
+	suite.Require().Len(locks, 1)
+
+	// check accumulation store is moved to new duration for native and synthetic coins
+	for _, denom := range []string{"stake", "stakesuffix"} {
+		accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+			LockQueryType: types.ByDuration,
+			Denom:         denom,
+			Duration:      time.Second * 2,
+		})
+		suite.Require().Equal("10", accum.String(), denom)
+		accum = suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+			LockQueryType: types.ByDuration,
+			Denom:         denom,
+			Duration:      time.Second,
+		})
+		suite.Require().Equal("10", accum.String(), denom)
+	}
+
+	// try to extend unlocking lock
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.ExtendLockupByID(suite.ctx, addr1, 1, time.Second*3)
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestMergeLocks() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	// lock coins, using the keeper directly as MsgLockTokens adds to existing locks
+	suite.LockTokens(addr1, coins, time.Second)                                  // 1
+	suite.LockTokens(addr1, coins, time.Second)                                  // 2
+	suite.LockTokens(addr1, coins, time.Second)                                  // 3
+	suite.LockTokens(addr1, coins, time.Second*2)                                // 4: other duration
+	suite.LockTokens(addr1, sdk.Coins{sdk.NewInt64Coin("foo", 10)}, time.Second) // 5: other denom
+	suite.LockTokens(addr2, coins, time.Second)                                  // 6: other owner
+	suite.LockTokens(addr1, coins, time.Second)                                  // 7: unlocking
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 7)
+	suite.Require().NoError(err)
+	suite.LockTokens(addr1, coins, time.Second) // 8: with synthetic lockup
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 8, "suffix", time.Second, false)
+	suite.Require().NoError(err)
+	suite.LockTokens(addr1, coins, time.Second) // 9: rolling
+	_, err = suite.app.LockupKeeper.SetLockRollingByID(suite.ctx, addr1, 9, true)
+	suite.Require().NoError(err)
+
+	for _, lockIDs := range [][]uint64{{1}, {1, 1}, {1, 4}, {1, 5}, {1, 6}, {1, 7}, {1, 8}, {1, 9}, {9, 1}, {1, 1111}} {
+		_, err := suite.app.LockupKeeper.MergeLocks(suite.ctx, addr1, lockIDs)
+		suite.Require().Error(err, "lock IDs: %v", lockIDs)
+	}
+
+	// merge locks
+	lock, err := suite.app.LockupKeeper.MergeLocks(suite.ctx, addr1, []uint64{2, 1, 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), lock.ID)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 30)}, lock.Coins)
+
+	// check merged locks are deleted, and lock refs point to the merged lock only
+	for _, lockID := range []uint64{1, 3} {
+		_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, lockID)
+		suite.Require().Error(err)
+	}
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 30)}, lock.Coins)
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 3) // the merged lock, the lock with synthetic lockup and the rolling lock
+	suite.Require().Equal(uint64(2), locks[0].ID)
+	suite.Require().False(locks[0].Rolling)
+
+	// check accumulation store is unchanged
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal("80", accum.String())
+}
+
+func (suite *KeeperTestSuite) TestTransferLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	// lock coins, one with synthetic lockup and one unlocking
+	suite.LockTokens(addr1, coins, time.Second)
+	err := suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 1, "suffix", time.Second, false)
+	suite.Require().NoError(err)
+	suite.LockTokens(addr1, coins, time.Second)
+	_, err = suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 2)
+	suite.Require().NoError(err)
+
+	// transfer fails for other owner, unknown lock and the same owner
+	_, err = suite.app.LockupKeeper.TransferLockByID(suite.ctx, addr2, 1, addr1)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.TransferLockByID(suite.ctx, addr1, 1111, addr2)
+	suite.Require().Error(err)
+	_, err = suite.app.LockupKeeper.TransferLockByID(suite.ctx, addr1, 1, addr1)
+	suite.Require().Error(err)
+
+	// transfer locks
+	for _, lockID := range []uint64{1, 2} {
+		lock, err := suite.app.LockupKeeper.TransferLockByID(suite.ctx, addr1, lockID, addr2)
+		suite.Require().NoError(err)
+		suite.Require().Equal(addr2.String(), lock.Owner)
+	}
+
+	// check lock refs are moved to the new owner
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1), 0)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr2), 2)
+	suite.Require().Equal(coins.Add(coins...), suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr2))
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr2))
+	lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), lock.Owner)
+
+	// check synthetic lockup and its refs are moved to the new owner
+	synthLock, err := suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, 1, "suffix")
+	suite.Require().NoError(err)
+	suite.Require().Equal(addr2.String(), synthLock.Owner)
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stakesuffix", time.Second)
+	suite.Require().Len(locks, 0)
+	locks = suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr2, "stakesuffix", time.Second)
+	suite.Require().Len(locks, 1)
+
+	// check accumulation store is unchanged
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal("20", accum.String())
+}
+
+func (suite *KeeperTestSuite) TestForceUnlockWithPenalty() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 100)}
+
+	// lock coins, one unlocking and one with synthetic lockup
+	suite.LockTokens(addr1, coins, time.Second*10)
+	suite.LockTokens(addr1, coins, time.Second*10)
+	_, err := suite.app.LockupKeeper.BeginUnlockPeriodLockByID(suite.ctx, 2)
+	suite.Require().NoError(err)
+	suite.LockTokens(addr1, coins, time.Second*10)
+	err = suite.app.LockupKeeper.CreateSyntheticLockup(suite.ctx, 3, "suffix", time.Second*10, false)
+	suite.Require().NoError(err)
+
+	// force unlocking is disabled by default
+	_, _, err = suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr1, 1)
+	suite.Require().Error(err)
+
+	params := suite.app.LockupKeeper.GetParams(suite.ctx)
+	params.ForceUnlockEnabled = true
+	params.ForceUnlockPenalty = sdk.NewDecWithPrec(2, 1)
+	suite.app.LockupKeeper.SetParams(suite.ctx, params)
+
+	// force unlock fails for other owner, unknown lock and lock with synthetic lockup
+	_, _, err = suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr2, 1)
+	suite.Require().Error(err)
+	_, _, err = suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr1, 1111)
+	suite.Require().Error(err)
+	_, _, err = suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr1, 3)
+	suite.Require().Error(err)
+
+	// lock with full duration remaining pays the full penalty
+	prevFeePool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	_, penalty, err := suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr1, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 20)}, penalty)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 80)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	feePool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(penalty...), feePool.Sub(prevFeePool))
+
+	// unlocking lock with half of its duration remaining pays half of the penalty
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 5))
+	_, penalty, err = suite.app.LockupKeeper.ForceUnlockWithPenaltyByID(suite.ctx, addr1, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 10)}, penalty)
+	suite.Require().Equal(sdk.Coins{sdk.NewInt64Coin("stake", 170)}, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+
+	// check force unlocked locks are deleted, along with their lock refs
+	for _, lockID := range []uint64{1, 2} {
+		_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, lockID)
+		suite.Require().Error(err)
+	}
+	locks := suite.app.LockupKeeper.GetAccountPeriodLocks(suite.ctx, addr1)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(3), locks[0].ID)
+	suite.Require().Equal(coins, suite.app.LockupKeeper.GetAccountLockedCoins(suite.ctx, addr1))
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1), 0)
+
+	// check accumulation store
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second * 10,
+	})
+	suite.Require().Equal("100", accum.String())
+}
+
+func (suite *KeeperTestSuite) TestRollingLock() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+
+	// lock coins, one rolling
+	suite.LockTokens(addr1, coins, time.Second)
+	suite.LockTokens(addr1, coins, time.Second)
+	_, err := suite.app.LockupKeeper.SetLockRollingByID(suite.ctx, addr2, 1, true)
+	suite.Require().Error(err)
+	lock, err := suite.app.LockupKeeper.SetLockRollingByID(suite.ctx, addr1, 1, true)
+	suite.Require().NoError(err)
+	suite.Require().True(lock.Rolling)
+
+	// begin unlocking both locks, and withdraw matured locks
+	suite.BeginUnlocking(addr1)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	hooks := &cancelUnlockHooks{}
+	suite.app.LockupKeeper.WithHooks(hooks).WithdrawAllMaturedLocks(suite.ctx)
+
+	// check rolling lock is relocked with an event and the hook run
+	suite.Require().Equal([]uint64{1}, hooks.lockIDs)
+	relocked := false
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.TypeEvtRelock {
+			relocked = true
+		}
+	}
+	suite.Require().True(relocked)
+
+	// check rolling lock is locked again, and the other lock is withdrawn
+	lock, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().False(lock.IsUnlocking())
+	suite.Require().True(lock.Rolling)
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 2)
+	suite.Require().Error(err)
+	suite.Require().Equal(coins, suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+	locks := suite.app.LockupKeeper.GetAccountLockedDurationNotUnlockingOnly(suite.ctx, addr1, "stake", time.Second)
+	suite.Require().Len(locks, 1)
+	suite.Require().Equal(uint64(1), locks[0].ID)
+	suite.Require().Len(suite.app.LockupKeeper.GetAccountUnlockingCoins(suite.ctx, addr1), 0)
+
+	// lock is withdrawn once opted out
+	_, err = suite.app.LockupKeeper.SetLockRollingByID(suite.ctx, addr1, 1, false)
+	suite.Require().NoError(err)
+	suite.BeginUnlocking(addr1)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	suite.app.LockupKeeper.WithdrawAllMaturedLocks(suite.ctx)
+
+	_, err = suite.app.LockupKeeper.GetLockByID(suite.ctx, 1)
+	suite.Require().Error(err)
+	suite.Require().Equal(coins.Add(coins...), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr1))
+
+	// check accumulation store
+	accum := suite.app.LockupKeeper.GetPeriodLocksAccumulation(suite.ctx, types.QueryCondition{
+		LockQueryType: types.ByDuration,
+		Denom:         "stake",
+		Duration:      time.Second,
+	})
+	suite.Require().Equal("0", accum.String())
+}
+
 func (suite *KeeperTestSuite) TestEndblockerWithdrawAllMaturedLockups() {
 	suite.SetupTest()
 
@@ -61,3 +61,36 @@ func (suite *KeeperTestSuite) TestMsgLockTokens() {
 	})
 	suite.Require().Equal(accum.String(), "20")
 }
+
+func (suite *KeeperTestSuite) TestMsgLockTokensRolling() {
+	suite.SetupTest()
+
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+	msgServer := keeper.NewMsgServerImpl(*suite.app.LockupKeeper)
+
+	// rolling lock is not added to the existing lock that is not rolling
+	suite.LockTokens(addr1, coins, time.Second)
+	err := simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins.Add(coins...))
+	suite.Require().NoError(err)
+	msg := types.NewMsgLockTokens(addr1, time.Second, coins)
+	msg.Rolling = true
+	_, err = msgServer.LockTokens(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	locks, err := suite.app.LockupKeeper.GetPeriodLocks(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 2)
+	suite.Require().False(locks[0].Rolling)
+	suite.Require().True(locks[1].Rolling)
+
+	// but is added to the existing rolling lock
+	_, err = msgServer.LockTokens(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().NoError(err)
+
+	locks, err = suite.app.LockupKeeper.GetPeriodLocks(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Len(locks, 2)
+	suite.Require().Equal(coins, locks[0].Coins)
+	suite.Require().Equal(coins.Add(coins...), locks[1].Coins)
+}
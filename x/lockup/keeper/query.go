@@ -160,9 +160,12 @@ func queryAccountLockedPastTime(ctx sdk.Context, req abci.RequestQuery, k Keeper
 		return nil, err
 	}
 
-	locks := k.GetAccountLockedPastTime(ctx, owner, params.Timestamp)
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, params.Pagination, accountLockedPastTimeRanges(ctx, owner, params.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, locks)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.AccountLockedPastTimeResponse{Locks: locks, Pagination: pageRes})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -183,9 +186,12 @@ func queryAccountUnlockedBeforeTime(ctx sdk.Context, req abci.RequestQuery, k Ke
 		return nil, err
 	}
 
-	unlocks := k.GetAccountUnlockedBeforeTime(ctx, owner, params.Timestamp)
+	unlocks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, params.Pagination, accountUnlockedBeforeTimeRanges(ctx, owner, params.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, unlocks)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.AccountUnlockedBeforeTimeResponse{Locks: unlocks, Pagination: pageRes})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -206,9 +212,12 @@ func queryAccountLockedPastTimeDenom(ctx sdk.Context, req abci.RequestQuery, k K
 		return nil, err
 	}
 
-	locks := k.GetAccountLockedPastTimeDenom(ctx, owner, params.Denom, params.Timestamp)
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, params.Pagination, accountLockedPastTimeDenomRanges(ctx, owner, params.Denom, params.Timestamp)...)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, locks)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.AccountLockedPastTimeDenomResponse{Locks: locks, Pagination: pageRes})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -250,9 +259,12 @@ func queryAccountLockedLongerDuration(ctx sdk.Context, req abci.RequestQuery, k
 		return nil, err
 	}
 
-	locks := k.GetAccountLockedLongerDuration(ctx, owner, params.Duration)
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, params.Pagination, accountLockedLongerDurationRanges(owner, params.Duration)...)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, locks)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.AccountLockedLongerDurationResponse{Locks: locks, Pagination: pageRes})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
@@ -273,9 +285,12 @@ func queryAccountLockedLongerDurationDenom(ctx sdk.Context, req abci.RequestQuer
 		return nil, err
 	}
 
-	locks := k.GetAccountLockedLongerDuration(ctx, owner, params.Duration)
+	locks, pageRes, err := k.getPaginatedLocksFromRanges(ctx, params.Pagination, accountLockedLongerDurationDenomRanges(owner, params.Denom, params.Duration)...)
+	if err != nil {
+		return nil, err
+	}
 
-	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, locks)
+	res, err := codec.MarshalJSONIndent(legacyQuerierCdc, types.AccountLockedLongerDurationDenomResponse{Locks: locks, Pagination: pageRes})
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
 	}
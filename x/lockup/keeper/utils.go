@@ -102,3 +102,125 @@ func syntheticCoins(coins sdk.Coins, suffix string) sdk.Coins {
 func combineLocks(pl1 []types.PeriodLock, pl2 []types.PeriodLock) []types.PeriodLock {
 	return append(pl1, pl2...)
 }
+
+// lockRefStore is a read only view of consecutive lock ref ranges as a single store, so that
+// the locks of a query spanning several ranges can be paginated with query.Paginate.
+// Its keys are the index of the range followed by the lock ref key, so a page key resumes
+// iterating in its range. Only iteration is supported.
+type lockRefStore struct {
+	sdk.KVStore
+	parent sdk.KVStore
+	ranges []lockRefRange
+}
+
+func newLockRefStore(parent sdk.KVStore, ranges ...lockRefRange) lockRefStore {
+	return lockRefStore{parent: parent, ranges: ranges}
+}
+
+func (s lockRefStore) Iterator(start, end []byte) sdk.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s lockRefStore) ReverseIterator(start, end []byte) sdk.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s lockRefStore) iterator(start, end []byte, reverse bool) sdk.Iterator {
+	iter := &lockRefIterator{parent: s.parent, reverse: reverse}
+	for i, lockRefRange := range s.ranges {
+		index := byte(i)
+		// narrow the range to the part within start and end
+		if len(start) != 0 {
+			if start[0] > index {
+				continue
+			}
+			if start[0] == index && bytes.Compare(start[1:], lockRefRange.start) > 0 {
+				lockRefRange.start = start[1:]
+			}
+		}
+		if end != nil {
+			if len(end) == 0 || end[0] < index {
+				continue
+			}
+			if end[0] == index && bytes.Compare(end[1:], lockRefRange.end) < 0 {
+				lockRefRange.end = end[1:]
+			}
+		}
+		if bytes.Compare(lockRefRange.start, lockRefRange.end) >= 0 {
+			continue
+		}
+		iter.ranges = append(iter.ranges, lockRefRange)
+		iter.indexes = append(iter.indexes, index)
+	}
+
+	if reverse {
+		for i, j := 0, len(iter.ranges)-1; i < j; i, j = i+1, j-1 {
+			iter.ranges[i], iter.ranges[j] = iter.ranges[j], iter.ranges[i]
+			iter.indexes[i], iter.indexes[j] = iter.indexes[j], iter.indexes[i]
+		}
+	}
+	iter.skipExhausted()
+	return iter
+}
+
+// lockRefIterator iterates lock ref ranges one after another, opening the iterator
+// of a range once the previous one is exhausted.
+type lockRefIterator struct {
+	parent  sdk.KVStore
+	ranges  []lockRefRange
+	indexes []byte
+	reverse bool
+
+	current sdk.Iterator
+	index   byte
+}
+
+func (it *lockRefIterator) skipExhausted() {
+	for (it.current == nil || !it.current.Valid()) && len(it.ranges) != 0 {
+		if it.current != nil {
+			it.current.Close()
+		}
+		if it.reverse {
+			it.current = it.parent.ReverseIterator(it.ranges[0].start, it.ranges[0].end)
+		} else {
+			it.current = it.parent.Iterator(it.ranges[0].start, it.ranges[0].end)
+		}
+		it.index = it.indexes[0]
+		it.ranges, it.indexes = it.ranges[1:], it.indexes[1:]
+	}
+}
+
+func (it *lockRefIterator) Domain() ([]byte, []byte) {
+	return nil, nil
+}
+
+func (it *lockRefIterator) Valid() bool {
+	return it.current != nil && it.current.Valid()
+}
+
+func (it *lockRefIterator) Next() {
+	it.current.Next()
+	it.skipExhausted()
+}
+
+func (it *lockRefIterator) Key() []byte {
+	return append([]byte{it.index}, it.current.Key()...)
+}
+
+func (it *lockRefIterator) Value() []byte {
+	return it.current.Value()
+}
+
+func (it *lockRefIterator) Error() error {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.Error()
+}
+
+func (it *lockRefIterator) Close() error {
+	if it.current == nil {
+		return nil
+	}
+	return it.current.Close()
+}
@@ -5,9 +5,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/osmosis-labs/osmosis/x/lockup/types"
 	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
 )
 
 func TestCombineKeys(t *testing.T) {
@@ -63,3 +66,56 @@ func TestLockRefKeys(t *testing.T) {
 	keys5, err := lockRefKeys(lock5)
 	require.Len(t, keys5, 12)
 }
+
+func TestLockRefStorePagination(t *testing.T) {
+	store := dbadapter.Store{DB: dbm.NewMemDB()}
+	for i, key := range []string{"a1", "a2", "a3", "b1", "b2"} {
+		store.Set([]byte(key), sdk.Uint64ToBigEndian(uint64(i+1)))
+	}
+	// all of the first range, and the second range without b1
+	lockRefStore := newLockRefStore(store, lockRefRange{[]byte("a"), []byte("b")}, lockRefRange{[]byte("b2"), []byte("c")})
+
+	paginate := func(pageReq *query.PageRequest) ([]uint64, *query.PageResponse) {
+		lockIDs := []uint64{}
+		pageRes, err := query.Paginate(lockRefStore, pageReq, func(key []byte, value []byte) error {
+			lockIDs = append(lockIDs, sdk.BigEndianToUint64(value))
+			return nil
+		})
+		require.NoError(t, err)
+		return lockIDs, pageRes
+	}
+
+	// default limit counts the total
+	lockIDs, pageRes := paginate(nil)
+	require.Equal(t, []uint64{1, 2, 3, 5}, lockIDs)
+	require.Nil(t, pageRes.NextKey)
+	require.Equal(t, uint64(4), pageRes.Total)
+
+	// key continues from the previous page, across ranges
+	lockIDs, pageRes = paginate(&query.PageRequest{Limit: 2})
+	require.Equal(t, []uint64{1, 2}, lockIDs)
+	require.Equal(t, append([]byte{0}, "a3"...), pageRes.NextKey)
+	lockIDs, pageRes = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.Equal(t, []uint64{3, 5}, lockIDs)
+	require.Nil(t, pageRes.NextKey)
+
+	// reverse
+	lockIDs, pageRes = paginate(&query.PageRequest{Limit: 2, Reverse: true})
+	require.Equal(t, []uint64{5, 3}, lockIDs)
+	require.Equal(t, append([]byte{0}, "a2"...), pageRes.NextKey)
+	lockIDs, pageRes = paginate(&query.PageRequest{Key: pageRes.NextKey, Limit: 2, Reverse: true})
+	require.Equal(t, []uint64{2, 1}, lockIDs)
+	require.Nil(t, pageRes.NextKey)
+
+	// offset with count total
+	lockIDs, pageRes = paginate(&query.PageRequest{Offset: 3, Limit: 10, CountTotal: true})
+	require.Equal(t, []uint64{5}, lockIDs)
+	require.Nil(t, pageRes.NextKey)
+	require.Equal(t, uint64(4), pageRes.Total)
+
+	// both offset and key
+	_, err := query.Paginate(lockRefStore, &query.PageRequest{Key: []byte{0}, Offset: 1}, func(key []byte, value []byte) error {
+		return nil
+	})
+	require.Error(t, err)
+}
@@ -11,6 +11,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgLockTokens{}, "osmosis/lockup/lock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlockingAll{}, "osmosis/lockup/begin-unlock-tokens", nil)
 	cdc.RegisterConcrete(&MsgBeginUnlocking{}, "osmosis/lockup/begin-unlock-period-lock", nil)
+	cdc.RegisterConcrete(&MsgExtendLockup{}, "osmosis/lockup/extend-lockup", nil)
+	cdc.RegisterConcrete(&MsgCancelUnlocking{}, "osmosis/lockup/cancel-unlocking", nil)
+	cdc.RegisterConcrete(&MsgMergeLocks{}, "osmosis/lockup/merge-locks", nil)
+	cdc.RegisterConcrete(&MsgTransferLock{}, "osmosis/lockup/transfer-lock", nil)
+	cdc.RegisterConcrete(&MsgForceUnlockWithPenalty{}, "osmosis/lockup/force-unlock-with-penalty", nil)
+	cdc.RegisterConcrete(&MsgSetLockRolling{}, "osmosis/lockup/set-lock-rolling", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,6 +25,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgLockTokens{},
 		&MsgBeginUnlockingAll{},
 		&MsgBeginUnlocking{},
+		&MsgExtendLockup{},
+		&MsgCancelUnlocking{},
+		&MsgMergeLocks{},
+		&MsgTransferLock{},
+		&MsgForceUnlockWithPenalty{},
+		&MsgSetLockRolling{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
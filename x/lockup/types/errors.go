@@ -12,4 +12,13 @@ var (
 	ErrSyntheticLockupAlreadyExists      = sdkerrors.Register(ModuleName, 2, "synthetic lockup already exists for same lock and suffix")
 	ErrSyntheticDurationLongerThanNative = sdkerrors.Register(ModuleName, 3, "synthetic lockup duration should be shorter than native lockup duration")
 	ErrLockupNotFound                    = sdkerrors.Register(ModuleName, 4, "lockup not found")
+	ErrLockUnlocking                     = sdkerrors.Register(ModuleName, 5, "lock is unlocking")
+	ErrInvalidLockDuration               = sdkerrors.Register(ModuleName, 6, "invalid lock duration")
+	ErrPartialUnlockSyntheticLock        = sdkerrors.Register(ModuleName, 7, "cannot partially unlock a lock with synthetic lockups")
+	ErrLockNotUnlocking                  = sdkerrors.Register(ModuleName, 8, "lock is not unlocking")
+	ErrCancelUnlockSyntheticLock         = sdkerrors.Register(ModuleName, 9, "cannot cancel unlocking of a lock with synthetic lockups")
+	ErrMergeSyntheticLock                = sdkerrors.Register(ModuleName, 10, "cannot merge a lock with synthetic lockups")
+	ErrMergeMismatchedLocks              = sdkerrors.Register(ModuleName, 11, "locks to merge should have the same owner, denoms, duration and rolling flag")
+	ErrForceUnlockDisabled               = sdkerrors.Register(ModuleName, 12, "force unlocking is disabled")
+	ErrForceUnlockSyntheticLock          = sdkerrors.Register(ModuleName, 13, "cannot force unlock a lock with synthetic lockups")
 )
@@ -6,11 +6,23 @@ const (
 	TypeEvtAddTokensToLock = "add_tokens_to_lock"
 	TypeEvtBeginUnlockAll  = "begin_unlock_all"
 	TypeEvtBeginUnlock     = "begin_unlock"
+	TypeEvtExtendLockup    = "extend_lockup"
+	TypeEvtCancelUnlock    = "cancel_unlock"
+	TypeEvtMergeLocks      = "merge_locks"
+	TypeEvtTransferLock    = "transfer_lock"
+	TypeEvtForceUnlock     = "force_unlock"
+	TypeEvtSetLockRolling  = "set_lock_rolling"
+	TypeEvtRelock          = "relock"
 
-	AttributePeriodLockID         = "period_lock_id"
-	AttributePeriodLockOwner      = "owner"
-	AttributePeriodLockAmount     = "amount"
-	AttributePeriodLockDuration   = "duration"
-	AttributePeriodLockUnlockTime = "unlock_time"
-	AttributeUnlockedCoins        = "unlocked_coins"
+	AttributePeriodLockID          = "period_lock_id"
+	AttributePeriodLockOwner       = "owner"
+	AttributePeriodLockAmount      = "amount"
+	AttributePeriodLockDuration    = "duration"
+	AttributePeriodLockUnlockTime  = "unlock_time"
+	AttributeUnlockedCoins         = "unlocked_coins"
+	AttributePeriodLockOldDuration = "old_duration"
+	AttributeMergedLockIDs         = "merged_lock_ids"
+	AttributePeriodLockNewOwner    = "new_owner"
+	AttributeForceUnlockPenalty    = "penalty"
+	AttributePeriodLockRolling     = "rolling"
 )
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,18 +24,70 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params holds parameters for the lockup module
+type Params struct {
+	// force_unlock_enabled allows owners to unlock their locks immediately, by
+	// paying a penalty to the community pool
+	ForceUnlockEnabled bool `protobuf:"varint,1,opt,name=force_unlock_enabled,json=forceUnlockEnabled,proto3" json:"force_unlock_enabled,omitempty" yaml:"force_unlock_enabled"`
+	// force_unlock_penalty is the fraction of the coins of a lock paid as the
+	// penalty to force unlock it with its full duration remaining, the penalty
+	// being scaled down by the fraction of the duration that remains
+	ForceUnlockPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=force_unlock_penalty,json=forceUnlockPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"force_unlock_penalty" yaml:"force_unlock_penalty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648db7c6ebb608b0, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetForceUnlockEnabled() bool {
+	if m != nil {
+		return m.ForceUnlockEnabled
+	}
+	return false
+}
+
 // GenesisState defines the lockup module's genesis state.
 type GenesisState struct {
 	LastLockId     uint64          `protobuf:"varint,1,opt,name=last_lock_id,json=lastLockId,proto3" json:"last_lock_id,omitempty"`
 	Locks          []PeriodLock    `protobuf:"bytes,2,rep,name=locks,proto3" json:"locks"`
 	SyntheticLocks []SyntheticLock `protobuf:"bytes,3,rep,name=synthetic_locks,json=syntheticLocks,proto3" json:"synthetic_locks"`
+	Params         Params          `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_648db7c6ebb608b0, []int{0}
+	return fileDescriptor_648db7c6ebb608b0, []int{1}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -84,31 +137,90 @@ func (m *GenesisState) GetSyntheticLocks() []SyntheticLock {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.lockup.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.lockup.GenesisState")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/genesis.proto", fileDescriptor_648db7c6ebb608b0) }
 
 var fileDescriptor_648db7c6ebb608b0 = []byte{
-	// 258 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0xcf, 0xc9, 0x4f, 0xce, 0x2e, 0x2d, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xca, 0xea, 0x41, 0x64, 0xa5,
-	0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x52, 0xfa, 0x20, 0x16, 0x44, 0x95, 0x94, 0x24, 0x9a, 0x19,
-	0x20, 0x0a, 0x22, 0xa5, 0xb4, 0x8d, 0x91, 0x8b, 0xc7, 0x1d, 0x62, 0x64, 0x70, 0x49, 0x62, 0x49,
-	0xaa, 0x90, 0x02, 0x17, 0x4f, 0x4e, 0x62, 0x71, 0x49, 0x3c, 0x48, 0x4d, 0x7c, 0x66, 0x8a, 0x04,
-	0xa3, 0x02, 0xa3, 0x06, 0x4b, 0x10, 0x17, 0x48, 0xcc, 0x27, 0x3f, 0x39, 0xdb, 0x33, 0x45, 0xc8,
-	0x8c, 0x8b, 0x15, 0x24, 0x59, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xa5, 0x87, 0xea,
-	0x06, 0xbd, 0x80, 0xd4, 0xa2, 0xcc, 0xfc, 0x14, 0x90, 0x62, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19,
-	0x82, 0x20, 0xca, 0x85, 0x7c, 0xb8, 0xf8, 0x8b, 0x2b, 0xf3, 0x4a, 0x32, 0x52, 0x4b, 0x32, 0x93,
-	0xe3, 0x21, 0x26, 0x30, 0x83, 0x4d, 0x90, 0x45, 0x37, 0x21, 0x18, 0xa6, 0x0c, 0xc9, 0x10, 0xbe,
-	0x62, 0x64, 0xc1, 0x62, 0x27, 0x8f, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0,
-	0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88,
-	0xd2, 0x4b, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0xac, 0x9b,
-	0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x57, 0xc0, 0xc2, 0xa1, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0x12, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xb6, 0x09, 0xb9, 0x6a, 0x01,
-	0x00, 0x00,
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6b, 0xdb, 0x30,
+	0x1c, 0xc5, 0xad, 0x24, 0x0b, 0x9b, 0x12, 0x32, 0x10, 0x61, 0x78, 0xd9, 0x66, 0x9b, 0x1c, 0x86,
+	0x2f, 0xb1, 0x21, 0x1b, 0x3b, 0xec, 0x68, 0x36, 0xb6, 0x42, 0x0a, 0x69, 0x42, 0x2f, 0xbd, 0x18,
+	0xd9, 0x56, 0x1d, 0x13, 0xdb, 0x32, 0x96, 0x02, 0xf5, 0xa9, 0x5f, 0xa1, 0x1f, 0x2b, 0xc7, 0x1c,
+	0x4b, 0x29, 0xa1, 0x24, 0xd7, 0x9e, 0xfa, 0x09, 0x8a, 0x25, 0x07, 0x92, 0xb4, 0x3d, 0xc9, 0x7e,
+	0xef, 0xe9, 0xc7, 0x5f, 0x4f, 0x82, 0x5f, 0x29, 0x4b, 0x28, 0x8b, 0x98, 0x1d, 0x53, 0x7f, 0xbe,
+	0xc8, 0xec, 0x90, 0xa4, 0x84, 0x45, 0xcc, 0xca, 0x72, 0xca, 0x29, 0xea, 0x54, 0xae, 0x25, 0xdd,
+	0x5e, 0x37, 0xa4, 0x21, 0x15, 0x96, 0x5d, 0x7e, 0xc9, 0x54, 0xef, 0xf3, 0x11, 0xa3, 0x5c, 0xa4,
+	0xd5, 0xbf, 0x07, 0xb0, 0x39, 0xc6, 0x39, 0x4e, 0x18, 0x3a, 0x83, 0xdd, 0x4b, 0x9a, 0xfb, 0xc4,
+	0x5d, 0xa4, 0x65, 0xc0, 0x25, 0x29, 0xf6, 0x62, 0x12, 0xa8, 0xc0, 0x00, 0xe6, 0x7b, 0x47, 0x7f,
+	0x5a, 0xeb, 0x5f, 0x0a, 0x9c, 0xc4, 0xbf, 0xfb, 0xaf, 0xa5, 0xfa, 0x13, 0x24, 0xe4, 0x73, 0xa1,
+	0xfe, 0x95, 0x22, 0xba, 0x3e, 0x42, 0x66, 0x24, 0xc5, 0x31, 0x2f, 0xd4, 0x9a, 0x01, 0xcc, 0x0f,
+	0xce, 0xe9, 0x72, 0xad, 0x2b, 0x77, 0x6b, 0xfd, 0x7b, 0x18, 0xf1, 0xd9, 0xc2, 0xb3, 0x7c, 0x9a,
+	0xd8, 0xbe, 0x18, 0xb5, 0x5a, 0x06, 0x2c, 0x98, 0xdb, 0xbc, 0xc8, 0x08, 0xb3, 0xfe, 0x10, 0xff,
+	0x8d, 0x01, 0x2a, 0xe6, 0xe1, 0x00, 0xe3, 0x4a, 0x7c, 0x04, 0xb0, 0xfd, 0x4f, 0x36, 0x36, 0xe5,
+	0x98, 0x13, 0x64, 0xc0, 0x76, 0x8c, 0x19, 0x77, 0xc5, 0xd6, 0x48, 0x1e, 0xae, 0x31, 0x81, 0xa5,
+	0x36, 0xa2, 0xfe, 0xfc, 0x24, 0x40, 0xbf, 0xe0, 0xbb, 0xd2, 0x64, 0x6a, 0xcd, 0xa8, 0x9b, 0xad,
+	0x61, 0xcf, 0x3a, 0xac, 0xd8, 0x1a, 0x93, 0x3c, 0xa2, 0x41, 0x19, 0x76, 0x1a, 0xe5, 0x01, 0x26,
+	0x32, 0x8e, 0x46, 0xf0, 0x23, 0x2b, 0x52, 0x3e, 0x23, 0x3c, 0xf2, 0x5d, 0x49, 0xa8, 0x0b, 0xc2,
+	0xb7, 0x63, 0xc2, 0x74, 0x17, 0xdb, 0x83, 0x74, 0xd8, 0xbe, 0xc8, 0xd0, 0x4f, 0xd8, 0xcc, 0xc4,
+	0xb5, 0xa8, 0x0d, 0x03, 0x98, 0xad, 0xe1, 0xa7, 0x17, 0x63, 0x08, 0xb7, 0xda, 0x5d, 0x65, 0x9d,
+	0xff, 0xcb, 0x8d, 0x06, 0x56, 0x1b, 0x0d, 0x3c, 0x6c, 0x34, 0x70, 0xb3, 0xd5, 0x94, 0xd5, 0x56,
+	0x53, 0x6e, 0xb7, 0x9a, 0x72, 0x61, 0xed, 0x75, 0x5c, 0x91, 0x06, 0x31, 0xf6, 0xd8, 0xee, 0xc7,
+	0xbe, 0xda, 0x3d, 0x0e, 0xd1, 0xb7, 0xd7, 0x14, 0xcf, 0xe3, 0xc7, 0xf3, 0x00, 0x85, 0xa3, 0xc4,
+	0x16, 0x7f, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ForceUnlockPenalty.Size()
+		i -= size
+		if _, err := m.ForceUnlockPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.ForceUnlockEnabled {
+		i--
+		if m.ForceUnlockEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -131,6 +243,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.SyntheticLocks) > 0 {
 		for iNdEx := len(m.SyntheticLocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -178,6 +300,20 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ForceUnlockEnabled {
+		n += 2
+	}
+	l = m.ForceUnlockPenalty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -199,6 +335,8 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -208,6 +346,110 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlockEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ForceUnlockEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceUnlockPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForceUnlockPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -324,6 +566,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -9,8 +9,15 @@ import (
 type LockupHooks interface {
 	OnTokenLocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
+	// OnCancelUnlock is run when a lock which started unlocking is locked for its duration again
+	OnCancelUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration)
 	OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time)
 	OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
+	OnLockupExtend(ctx sdk.Context, lockID uint64, previousDuration, newDuration time.Duration)
+	OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID uint64, splitLockID uint64, splitAmount sdk.Coins)
+	OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID uint64, mergedLockIDs []uint64, mergedAmount sdk.Coins)
+	// BeforeLockTransfer is run before the ownership of a lock is transferred, returning an error rejects the transfer
+	BeforeLockTransfer(ctx sdk.Context, lockID uint64, previousOwner, newOwner sdk.AccAddress) error
 }
 
 var _ LockupHooks = MultiLockupHooks{}
@@ -34,6 +41,12 @@ func (h MultiLockupHooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress,
 	}
 }
 
+func (h MultiLockupHooks) OnCancelUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration) {
+	for i := range h {
+		h[i].OnCancelUnlock(ctx, address, lockID, amount, lockDuration)
+	}
+}
+
 func (h MultiLockupHooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 	for i := range h {
 		h[i].OnTokenUnlocked(ctx, address, lockID, amount, lockDuration, unlockTime)
@@ -45,3 +58,30 @@ func (h MultiLockupHooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount
 		h[i].OnTokenSlashed(ctx, lockID, amount)
 	}
 }
+
+func (h MultiLockupHooks) OnLockupExtend(ctx sdk.Context, lockID uint64, previousDuration, newDuration time.Duration) {
+	for i := range h {
+		h[i].OnLockupExtend(ctx, lockID, previousDuration, newDuration)
+	}
+}
+
+func (h MultiLockupHooks) OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID uint64, splitLockID uint64, splitAmount sdk.Coins) {
+	for i := range h {
+		h[i].OnLockSplit(ctx, address, lockID, splitLockID, splitAmount)
+	}
+}
+
+func (h MultiLockupHooks) OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID uint64, mergedLockIDs []uint64, mergedAmount sdk.Coins) {
+	for i := range h {
+		h[i].OnLocksMerged(ctx, address, lockID, mergedLockIDs, mergedAmount)
+	}
+}
+
+func (h MultiLockupHooks) BeforeLockTransfer(ctx sdk.Context, lockID uint64, previousOwner, newOwner sdk.AccAddress) error {
+	for i := range h {
+		if err := h[i].BeforeLockTransfer(ctx, lockID, previousOwner, newOwner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -64,6 +64,9 @@ type PeriodLock struct {
 	Duration time.Duration                            `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
 	EndTime  time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
 	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	// rolling locks are locked again for their duration when they finish
+	// unlocking, instead of being withdrawn
+	Rolling bool `protobuf:"varint,6,opt,name=rolling,proto3" json:"rolling,omitempty" yaml:"rolling"`
 }
 
 func (m *PeriodLock) Reset()         { *m = PeriodLock{} }
@@ -134,6 +137,13 @@ func (m *PeriodLock) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *PeriodLock) GetRolling() bool {
+	if m != nil {
+		return m.Rolling
+	}
+	return false
+}
+
 type QueryCondition struct {
 	LockQueryType LockQueryType `protobuf:"varint,1,opt,name=lock_query_type,json=lockQueryType,proto3,enum=osmosis.lockup.LockQueryType" json:"lock_query_type,omitempty"`
 	Denom         string        `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -209,10 +219,11 @@ type SyntheticLock struct {
 	UnderlyingLockId uint64 `protobuf:"varint,1,opt,name=underlying_lock_id,json=underlyingLockId,proto3" json:"underlying_lock_id,omitempty"`
 	Suffix           string `protobuf:"bytes,2,opt,name=suffix,proto3" json:"suffix,omitempty"`
 	// used for unbonding synthetic lockups, for active synthetic lockups, this value is set to uninitialized value
-	EndTime  time.Time                                `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
-	Duration time.Duration                            `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
-	Coins    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
-	Owner    string                                   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	EndTime  time.Time     `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The coins from the underlying lock ID that are synthetically locked
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+	Owner string                                   `protobuf:"bytes,6,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 }
 
 func (m *SyntheticLock) Reset()         { *m = SyntheticLock{} }
@@ -300,45 +311,46 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x3d, 0x6f, 0xd3, 0x40,
-	0x18, 0xc7, 0xed, 0xbc, 0xd1, 0x1e, 0x34, 0x8d, 0x4e, 0x15, 0x4a, 0x03, 0xd8, 0x91, 0x07, 0x14,
-	0xa1, 0xf6, 0x4c, 0xca, 0xc6, 0xe8, 0x16, 0x89, 0x48, 0x0c, 0x60, 0x2a, 0x06, 0x96, 0xc8, 0x2f,
-	0x17, 0xe7, 0x14, 0xdb, 0x67, 0xfc, 0x02, 0xf5, 0x37, 0x60, 0xec, 0x08, 0x0b, 0x0b, 0x1b, 0x9f,
-	0xa4, 0x63, 0x47, 0xa6, 0x14, 0x25, 0x62, 0x61, 0xec, 0x27, 0x40, 0x77, 0x67, 0xe7, 0xa5, 0x08,
-	0xd4, 0x81, 0x4e, 0xce, 0xe3, 0xff, 0x3d, 0xff, 0x7b, 0xee, 0x77, 0x7f, 0x07, 0xec, 0xd2, 0x24,
-	0xa0, 0x09, 0x49, 0x74, 0x9f, 0x3a, 0x93, 0x2c, 0xe2, 0x0f, 0x14, 0xc5, 0x34, 0xa5, 0xb0, 0x59,
-	0x48, 0x48, 0x48, 0x9d, 0x1d, 0x8f, 0x7a, 0x94, 0x4b, 0x3a, 0xfb, 0x25, 0x56, 0x75, 0x14, 0x8f,
-	0x52, 0xcf, 0xc7, 0x3a, 0xaf, 0xec, 0x6c, 0xa4, 0xbb, 0x59, 0x6c, 0xa5, 0x84, 0x86, 0x85, 0xae,
-	0x5e, 0xd5, 0x53, 0x12, 0xe0, 0x24, 0xb5, 0x82, 0xa8, 0x34, 0x70, 0xf8, 0x3e, 0xba, 0x6d, 0x25,
-	0x58, 0x7f, 0xdf, 0xb7, 0x71, 0x6a, 0xf5, 0x75, 0x87, 0x92, 0xc2, 0x40, 0xfb, 0x59, 0x01, 0xe0,
-	0x25, 0x8e, 0x09, 0x75, 0x5f, 0x50, 0x67, 0x02, 0x9b, 0xa0, 0x32, 0x38, 0x6a, 0xcb, 0x5d, 0xb9,
-	0x57, 0x33, 0x2b, 0x83, 0x23, 0xf8, 0x10, 0xd4, 0xe9, 0x87, 0x10, 0xc7, 0xed, 0x4a, 0x57, 0xee,
-	0x6d, 0x1a, 0xad, 0xcb, 0xa9, 0x7a, 0x27, 0xb7, 0x02, 0xff, 0xa9, 0xc6, 0x5f, 0x6b, 0xa6, 0x90,
-	0xe1, 0x18, 0x6c, 0x94, 0x93, 0xb5, 0xab, 0x5d, 0xb9, 0x77, 0xfb, 0x60, 0x17, 0x89, 0xd1, 0x50,
-	0x39, 0x1a, 0x3a, 0x2a, 0x16, 0x18, 0xfd, 0xb3, 0xa9, 0x2a, 0xfd, 0x9a, 0xaa, 0xb0, 0x6c, 0xd9,
-	0xa3, 0x01, 0x49, 0x71, 0x10, 0xa5, 0xf9, 0xe5, 0x54, 0xdd, 0x16, 0xfe, 0xa5, 0xa6, 0x7d, 0xba,
-	0x50, 0x65, 0x73, 0xe1, 0x0e, 0x4d, 0xb0, 0x81, 0x43, 0x77, 0xc8, 0xce, 0xd9, 0xae, 0xf1, 0x9d,
-	0x3a, 0x7f, 0xec, 0x74, 0x5c, 0x42, 0x30, 0xee, 0xb1, 0xad, 0x96, 0xa6, 0x65, 0xa7, 0x76, 0xca,
-	0x4c, 0x6f, 0xe1, 0xd0, 0x65, 0x4b, 0xa1, 0x05, 0xea, 0x0c, 0x49, 0xd2, 0xae, 0x77, 0xab, 0x7c,
-	0x74, 0x01, 0x0d, 0x31, 0x68, 0xa8, 0x80, 0x86, 0x0e, 0x29, 0x09, 0x8d, 0xc7, 0xcc, 0xef, 0xdb,
-	0x85, 0xda, 0xf3, 0x48, 0x3a, 0xce, 0x6c, 0xe4, 0xd0, 0x40, 0x2f, 0x08, 0x8b, 0xc7, 0x7e, 0xe2,
-	0x4e, 0xf4, 0x34, 0x8f, 0x70, 0xc2, 0x1b, 0x12, 0x53, 0x38, 0x6b, 0x9f, 0x2b, 0xa0, 0xf9, 0x2a,
-	0xc3, 0x71, 0x7e, 0x48, 0x43, 0x97, 0xf0, 0x93, 0x3c, 0x03, 0xdb, 0xec, 0xee, 0x87, 0xef, 0xd8,
-	0xeb, 0x21, 0xeb, 0xe1, 0xe0, 0x9b, 0x07, 0x0f, 0xd0, 0x7a, 0x36, 0x10, 0xbb, 0x1a, 0xde, 0x7c,
-	0x9c, 0x47, 0xd8, 0xdc, 0xf2, 0x57, 0x4b, 0xb8, 0x03, 0xea, 0x2e, 0x0e, 0x69, 0x20, 0xae, 0xc8,
-	0x14, 0x05, 0xc3, 0x74, 0xfd, 0x0b, 0xb9, 0x42, 0xe9, 0x6f, 0xe8, 0xdf, 0x80, 0xcd, 0x45, 0xbc,
-	0xae, 0xc1, 0xfe, 0x7e, 0xe1, 0xda, 0x12, 0xae, 0x8b, 0x56, 0x01, 0x7f, 0x69, 0xa5, 0x7d, 0xa9,
-	0x82, 0xad, 0xd7, 0x79, 0x98, 0x8e, 0x71, 0x4a, 0x1c, 0x1e, 0xc3, 0x3d, 0x00, 0xb3, 0xd0, 0xc5,
-	0xb1, 0x9f, 0x93, 0xd0, 0x1b, 0x72, 0x4a, 0xc4, 0x2d, 0x62, 0xd9, 0x5a, 0x2a, 0x6c, 0xed, 0xc0,
-	0x85, 0x77, 0x41, 0x23, 0xc9, 0x46, 0x23, 0x72, 0x52, 0x20, 0x28, 0xaa, 0xb5, 0xa8, 0x54, 0xff,
-	0x53, 0x54, 0x56, 0x83, 0x5e, 0xbb, 0xd1, 0xa0, 0xdf, 0x7c, 0x28, 0x97, 0x5f, 0x77, 0xe3, 0x9f,
-	0x5f, 0xf7, 0xa3, 0x3e, 0xd8, 0x5a, 0x8b, 0x20, 0x6c, 0x02, 0x60, 0xe4, 0xe5, 0x31, 0x5b, 0x12,
-	0x04, 0xa0, 0x61, 0xe4, 0x8c, 0x4f, 0x4b, 0xee, 0xd4, 0x3e, 0x7e, 0x55, 0x24, 0xe3, 0xf9, 0xd9,
-	0x4c, 0x91, 0xcf, 0x67, 0x8a, 0xfc, 0x63, 0xa6, 0xc8, 0xa7, 0x73, 0x45, 0x3a, 0x9f, 0x2b, 0xd2,
-	0xf7, 0xb9, 0x22, 0xbd, 0x45, 0x2b, 0x53, 0x16, 0x39, 0xdf, 0xf7, 0x2d, 0x3b, 0x29, 0x0b, 0xfd,
-	0xa4, 0xfc, 0xb7, 0xe4, 0x13, 0xdb, 0x0d, 0xce, 0xf5, 0xc9, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xf4, 0x94, 0xd1, 0x40, 0x4c, 0x05, 0x00, 0x00,
+	// 624 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x3b, 0x6f, 0xd4, 0x4c,
+	0x14, 0xb5, 0xf7, 0x95, 0x64, 0xbe, 0x2f, 0xce, 0x6a, 0x14, 0x21, 0x67, 0x01, 0x7b, 0xe5, 0x02,
+	0xad, 0x50, 0x32, 0x66, 0x43, 0x47, 0xe9, 0x04, 0x89, 0x48, 0x14, 0x60, 0x22, 0x0a, 0x9a, 0x95,
+	0x1f, 0x13, 0x67, 0x14, 0xdb, 0x63, 0xfc, 0x80, 0xf8, 0x1f, 0x50, 0x46, 0xa2, 0x81, 0x86, 0x86,
+	0x8e, 0x5f, 0x92, 0x32, 0x25, 0xd5, 0x06, 0x25, 0x1d, 0x65, 0x7e, 0x01, 0x9a, 0x87, 0x37, 0xd9,
+	0x20, 0x50, 0x0a, 0xa0, 0xb2, 0xaf, 0xcf, 0xbd, 0xe7, 0xde, 0x39, 0xf7, 0x78, 0xc0, 0x1a, 0x2d,
+	0x12, 0x5a, 0x90, 0xc2, 0x8e, 0x69, 0x70, 0x50, 0x65, 0xfc, 0x81, 0xb2, 0x9c, 0x96, 0x14, 0x6a,
+	0x12, 0x42, 0x02, 0x1a, 0xac, 0x46, 0x34, 0xa2, 0x1c, 0xb2, 0xd9, 0x9b, 0xc8, 0x1a, 0x18, 0x11,
+	0xa5, 0x51, 0x8c, 0x6d, 0x1e, 0xf9, 0xd5, 0x9e, 0x1d, 0x56, 0xb9, 0x57, 0x12, 0x9a, 0x4a, 0xdc,
+	0xbc, 0x8e, 0x97, 0x24, 0xc1, 0x45, 0xe9, 0x25, 0x59, 0x43, 0x10, 0xf0, 0x3e, 0xb6, 0xef, 0x15,
+	0xd8, 0x7e, 0x33, 0xf6, 0x71, 0xe9, 0x8d, 0xed, 0x80, 0x12, 0x49, 0x60, 0xbd, 0x6f, 0x03, 0xf0,
+	0x0c, 0xe7, 0x84, 0x86, 0x4f, 0x69, 0x70, 0x00, 0x35, 0xd0, 0xda, 0xd9, 0xd6, 0xd5, 0xa1, 0x3a,
+	0xea, 0xb8, 0xad, 0x9d, 0x6d, 0x78, 0x0f, 0x74, 0xe9, 0xdb, 0x14, 0xe7, 0x7a, 0x6b, 0xa8, 0x8e,
+	0x96, 0x9c, 0xfe, 0xc5, 0xd4, 0xfc, 0xbf, 0xf6, 0x92, 0xf8, 0x91, 0xc5, 0x3f, 0x5b, 0xae, 0x80,
+	0xe1, 0x3e, 0x58, 0x6c, 0x26, 0xd3, 0xdb, 0x43, 0x75, 0xf4, 0xdf, 0xe6, 0x1a, 0x12, 0xa3, 0xa1,
+	0x66, 0x34, 0xb4, 0x2d, 0x13, 0x9c, 0xf1, 0xf1, 0xd4, 0x54, 0xbe, 0x4f, 0x4d, 0xd8, 0x94, 0xac,
+	0xd3, 0x84, 0x94, 0x38, 0xc9, 0xca, 0xfa, 0x62, 0x6a, 0xae, 0x08, 0xfe, 0x06, 0xb3, 0x3e, 0x9c,
+	0x9a, 0xaa, 0x3b, 0x63, 0x87, 0x2e, 0x58, 0xc4, 0x69, 0x38, 0x61, 0xe7, 0xd4, 0x3b, 0xbc, 0xd3,
+	0xe0, 0xa7, 0x4e, 0xbb, 0x8d, 0x08, 0xce, 0x6d, 0xd6, 0xea, 0x92, 0xb4, 0xa9, 0xb4, 0x8e, 0x18,
+	0xe9, 0x02, 0x4e, 0x43, 0x96, 0x0a, 0x3d, 0xd0, 0x65, 0x92, 0x14, 0x7a, 0x77, 0xd8, 0xe6, 0xa3,
+	0x0b, 0xd1, 0x10, 0x13, 0x0d, 0x49, 0xd1, 0xd0, 0x16, 0x25, 0xa9, 0xf3, 0x80, 0xf1, 0x7d, 0x39,
+	0x35, 0x47, 0x11, 0x29, 0xf7, 0x2b, 0x1f, 0x05, 0x34, 0xb1, 0xa5, 0xc2, 0xe2, 0xb1, 0x51, 0x84,
+	0x07, 0x76, 0x59, 0x67, 0xb8, 0xe0, 0x05, 0x85, 0x2b, 0x98, 0xe1, 0x3a, 0x58, 0xc8, 0x69, 0x1c,
+	0x93, 0x34, 0xd2, 0x7b, 0x43, 0x75, 0xb4, 0xe8, 0xc0, 0x8b, 0xa9, 0xa9, 0x89, 0xa9, 0x24, 0x60,
+	0xb9, 0x4d, 0x8a, 0xf5, 0xb1, 0x05, 0xb4, 0xe7, 0x15, 0xce, 0xeb, 0x2d, 0x9a, 0x86, 0x84, 0x9f,
+	0xfb, 0x31, 0x58, 0x61, 0x4e, 0x99, 0xbc, 0x66, 0x9f, 0x27, 0xac, 0x03, 0x5f, 0x93, 0xb6, 0x79,
+	0x17, 0xcd, 0x3b, 0x09, 0xb1, 0x45, 0xf2, 0xe2, 0xdd, 0x3a, 0xc3, 0xee, 0x72, 0x7c, 0x35, 0x84,
+	0xab, 0xa0, 0x1b, 0xe2, 0x94, 0x26, 0x62, 0xa1, 0xae, 0x08, 0x98, 0xa8, 0x37, 0x5f, 0xdf, 0x35,
+	0x4d, 0x7f, 0xb5, 0xa8, 0x97, 0x60, 0x69, 0x66, 0xc6, 0x1b, 0x6c, 0xea, 0x8e, 0x64, 0xed, 0x0b,
+	0xd6, 0x59, 0xa9, 0x58, 0xd5, 0x25, 0x95, 0xf5, 0xa9, 0x0d, 0x96, 0x5f, 0xd4, 0x69, 0xb9, 0x8f,
+	0x4b, 0x12, 0x70, 0xd3, 0xae, 0x03, 0x58, 0xa5, 0x21, 0xce, 0xe3, 0x9a, 0xa4, 0xd1, 0x84, 0xab,
+	0x44, 0x42, 0x69, 0xe2, 0xfe, 0x25, 0xc2, 0x72, 0x77, 0x42, 0x78, 0x0b, 0xf4, 0x8a, 0x6a, 0x6f,
+	0x8f, 0x1c, 0x4a, 0x09, 0x64, 0x34, 0x67, 0xac, 0xf6, 0x1f, 0x32, 0xd6, 0xd5, 0xdf, 0xa2, 0xf3,
+	0x57, 0x7f, 0x8b, 0x7f, 0x60, 0xe1, 0xd9, 0x5d, 0xd0, 0xfb, 0xed, 0x5d, 0x70, 0x7f, 0x0c, 0x96,
+	0xe7, 0x2c, 0x08, 0x35, 0x00, 0x9c, 0xba, 0x39, 0x66, 0x5f, 0x81, 0x00, 0xf4, 0x9c, 0x9a, 0xe9,
+	0xd3, 0x57, 0x07, 0x9d, 0x77, 0x9f, 0x0d, 0xc5, 0x79, 0x72, 0x7c, 0x66, 0xa8, 0x27, 0x67, 0x86,
+	0xfa, 0xed, 0xcc, 0x50, 0x8f, 0xce, 0x0d, 0xe5, 0xe4, 0xdc, 0x50, 0xbe, 0x9e, 0x1b, 0xca, 0x2b,
+	0x74, 0x65, 0x4a, 0xe9, 0xf3, 0x8d, 0xd8, 0xf3, 0x8b, 0x26, 0xb0, 0x0f, 0x9b, 0xbb, 0x95, 0x4f,
+	0xec, 0xf7, 0xb8, 0xae, 0x0f, 0x7f, 0x0c, 0x00, 0xb9, 0xd8, 0x9e, 0xb2, 0x7a, 0x05, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
@@ -361,6 +373,16 @@ func (m *PeriodLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Rolling {
+		i--
+		if m.Rolling {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -563,6 +585,9 @@ func (m *PeriodLock) Size() (n int) {
 			n += 1 + l + sovLock(uint64(l))
 		}
 	}
+	if m.Rolling {
+		n += 2
+	}
 	return n
 }
 
@@ -802,6 +827,26 @@ func (m *PeriodLock) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rolling", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLock
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Rolling = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLock(dAtA[iNdEx:])
@@ -5,13 +5,20 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // constants
 const (
-	TypeMsgLockTokens        = "lock_tokens"
-	TypeMsgBeginUnlockingAll = "begin_unlocking_all"
-	TypeMsgBeginUnlocking    = "begin_unlocking"
+	TypeMsgLockTokens             = "lock_tokens"
+	TypeMsgBeginUnlockingAll      = "begin_unlocking_all"
+	TypeMsgBeginUnlocking         = "begin_unlocking"
+	TypeMsgExtendLockup           = "extend_lockup"
+	TypeMsgCancelUnlocking        = "cancel_unlocking"
+	TypeMsgMergeLocks             = "merge_locks"
+	TypeMsgTransferLock           = "transfer_lock"
+	TypeMsgForceUnlockWithPenalty = "force_unlock_with_penalty"
+	TypeMsgSetLockRolling         = "set_lock_rolling"
 )
 
 var _ sdk.Msg = &MsgLockTokens{}
@@ -65,17 +72,22 @@ func (m MsgBeginUnlockingAll) GetSigners() []sdk.AccAddress {
 
 var _ sdk.Msg = &MsgBeginUnlocking{}
 
-// NewMsgBeginUnlocking creates a message to begin unlocking the tokens of a specific lock
-func NewMsgBeginUnlocking(owner sdk.AccAddress, id uint64) *MsgBeginUnlocking {
+// NewMsgBeginUnlocking creates a message to begin unlocking the tokens of a specific lock.
+// Only the given coins of the lock begin unlocking, or all of them if none are given.
+func NewMsgBeginUnlocking(owner sdk.AccAddress, id uint64, coins sdk.Coins) *MsgBeginUnlocking {
 	return &MsgBeginUnlocking{
 		Owner: owner.String(),
 		ID:    id,
+		Coins: coins,
 	}
 }
 
 func (m MsgBeginUnlocking) Route() string { return RouterKey }
 func (m MsgBeginUnlocking) Type() string  { return TypeMsgBeginUnlocking }
 func (m MsgBeginUnlocking) ValidateBasic() error {
+	if err := m.Coins.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
 	return nil
 }
 func (m MsgBeginUnlocking) GetSignBytes() []byte {
@@ -85,3 +97,181 @@ func (m MsgBeginUnlocking) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgExtendLockup{}
+
+// NewMsgExtendLockup creates a message to extend the duration of a lock
+func NewMsgExtendLockup(owner sdk.AccAddress, id uint64, duration time.Duration) *MsgExtendLockup {
+	return &MsgExtendLockup{
+		Owner:    owner.String(),
+		ID:       id,
+		Duration: duration,
+	}
+}
+
+func (m MsgExtendLockup) Route() string { return RouterKey }
+func (m MsgExtendLockup) Type() string  { return TypeMsgExtendLockup }
+func (m MsgExtendLockup) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("duration should be positive: %d < 0", m.Duration)
+	}
+	return nil
+}
+func (m MsgExtendLockup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgExtendLockup) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgCancelUnlocking{}
+
+// NewMsgCancelUnlocking creates a message to return an unlocking lock to the locked state
+func NewMsgCancelUnlocking(owner sdk.AccAddress, id uint64) *MsgCancelUnlocking {
+	return &MsgCancelUnlocking{
+		Owner: owner.String(),
+		ID:    id,
+	}
+}
+
+func (m MsgCancelUnlocking) Route() string { return RouterKey }
+func (m MsgCancelUnlocking) Type() string  { return TypeMsgCancelUnlocking }
+func (m MsgCancelUnlocking) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return nil
+}
+func (m MsgCancelUnlocking) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgCancelUnlocking) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgMergeLocks{}
+
+// NewMsgMergeLocks creates a message to merge locks into the first of them
+func NewMsgMergeLocks(owner sdk.AccAddress, ids []uint64) *MsgMergeLocks {
+	return &MsgMergeLocks{
+		Owner: owner.String(),
+		IDs:   ids,
+	}
+}
+
+func (m MsgMergeLocks) Route() string { return RouterKey }
+func (m MsgMergeLocks) Type() string  { return TypeMsgMergeLocks }
+func (m MsgMergeLocks) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if len(m.IDs) < 2 {
+		return fmt.Errorf("at least 2 locks should be merged, got %d", len(m.IDs))
+	}
+	ids := make(map[uint64]bool, len(m.IDs))
+	for _, id := range m.IDs {
+		if ids[id] {
+			return fmt.Errorf("duplicate lock ID %d", id)
+		}
+		ids[id] = true
+	}
+	return nil
+}
+func (m MsgMergeLocks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgMergeLocks) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgTransferLock{}
+
+// NewMsgTransferLock creates a message to transfer a lock to a new owner
+func NewMsgTransferLock(owner sdk.AccAddress, id uint64, newOwner sdk.AccAddress) *MsgTransferLock {
+	return &MsgTransferLock{
+		Owner:    owner.String(),
+		ID:       id,
+		NewOwner: newOwner.String(),
+	}
+}
+
+func (m MsgTransferLock) Route() string { return RouterKey }
+func (m MsgTransferLock) Type() string  { return TypeMsgTransferLock }
+func (m MsgTransferLock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(m.NewOwner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address (%s)", err)
+	}
+	if m.Owner == m.NewOwner {
+		return fmt.Errorf("new owner should differ from the owner")
+	}
+	return nil
+}
+func (m MsgTransferLock) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgTransferLock) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgForceUnlockWithPenalty{}
+
+// NewMsgForceUnlockWithPenalty creates a message to unlock a lock immediately, paying a penalty
+func NewMsgForceUnlockWithPenalty(owner sdk.AccAddress, id uint64) *MsgForceUnlockWithPenalty {
+	return &MsgForceUnlockWithPenalty{
+		Owner: owner.String(),
+		ID:    id,
+	}
+}
+
+func (m MsgForceUnlockWithPenalty) Route() string { return RouterKey }
+func (m MsgForceUnlockWithPenalty) Type() string  { return TypeMsgForceUnlockWithPenalty }
+func (m MsgForceUnlockWithPenalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return nil
+}
+func (m MsgForceUnlockWithPenalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgForceUnlockWithPenalty) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
+
+var _ sdk.Msg = &MsgSetLockRolling{}
+
+// NewMsgSetLockRolling creates a message to opt a lock in or out of being locked again when it finishes unlocking
+func NewMsgSetLockRolling(owner sdk.AccAddress, id uint64, rolling bool) *MsgSetLockRolling {
+	return &MsgSetLockRolling{
+		Owner:   owner.String(),
+		ID:      id,
+		Rolling: rolling,
+	}
+}
+
+func (m MsgSetLockRolling) Route() string { return RouterKey }
+func (m MsgSetLockRolling) Type() string  { return TypeMsgSetLockRolling }
+func (m MsgSetLockRolling) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address (%s)", err)
+	}
+	return nil
+}
+func (m MsgSetLockRolling) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgSetLockRolling) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
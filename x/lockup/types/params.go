@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyForceUnlockEnabled = []byte("ForceUnlockEnabled")
+	KeyForceUnlockPenalty = []byte("ForceUnlockPenalty")
+)
+
+var DefaultForceUnlockPenalty = sdk.NewDecWithPrec(25, 2)
+
+// ParamTable for lockup module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(forceUnlockEnabled bool, forceUnlockPenalty sdk.Dec) Params {
+	return Params{
+		ForceUnlockEnabled: forceUnlockEnabled,
+		ForceUnlockPenalty: forceUnlockPenalty,
+	}
+}
+
+// default lockup module parameters.
+// Force unlocking is disabled by default, and left to governance to turn on.
+func DefaultParams() Params {
+	return Params{
+		ForceUnlockEnabled: false,
+		ForceUnlockPenalty: DefaultForceUnlockPenalty,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateForceUnlockEnabled(p.ForceUnlockEnabled); err != nil {
+		return err
+	}
+
+	if err := validateForceUnlockPenalty(p.ForceUnlockPenalty); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyForceUnlockEnabled, &p.ForceUnlockEnabled, validateForceUnlockEnabled),
+		paramtypes.NewParamSetPair(KeyForceUnlockPenalty, &p.ForceUnlockPenalty, validateForceUnlockPenalty),
+	}
+}
+
+func validateForceUnlockEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateForceUnlockPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("force unlock penalty must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
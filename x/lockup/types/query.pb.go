@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -463,6 +464,8 @@ func (m *AccountLockedCoinsResponse) GetCoins() github_com_cosmos_cosmos_sdk_typ
 type AccountLockedPastTimeRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeRequest) Reset()         { *m = AccountLockedPastTimeRequest{} }
@@ -512,8 +515,17 @@ func (m *AccountLockedPastTimeRequest) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *AccountLockedPastTimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeResponse) Reset()         { *m = AccountLockedPastTimeResponse{} }
@@ -556,9 +568,18 @@ func (m *AccountLockedPastTimeResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedPastTimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeNotUnlockingOnlyRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Reset() {
@@ -612,8 +633,17 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) GetTimestamp() time.Time
 	return time.Time{}
 }
 
+func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeNotUnlockingOnlyResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Reset() {
@@ -660,9 +690,18 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) GetLocks() []PeriodLock
 	return nil
 }
 
+func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountUnlockedBeforeTimeRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountUnlockedBeforeTimeRequest) Reset()         { *m = AccountUnlockedBeforeTimeRequest{} }
@@ -712,8 +751,17 @@ func (m *AccountUnlockedBeforeTimeRequest) GetTimestamp() time.Time {
 	return time.Time{}
 }
 
+func (m *AccountUnlockedBeforeTimeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountUnlockedBeforeTimeResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountUnlockedBeforeTimeResponse) Reset()         { *m = AccountUnlockedBeforeTimeResponse{} }
@@ -756,10 +804,19 @@ func (m *AccountUnlockedBeforeTimeResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountUnlockedBeforeTimeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeDenomRequest struct {
 	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Timestamp time.Time `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
 	Denom     string    `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeDenomRequest) Reset()         { *m = AccountLockedPastTimeDenomRequest{} }
@@ -816,8 +873,17 @@ func (m *AccountLockedPastTimeDenomRequest) GetDenom() string {
 	return ""
 }
 
+func (m *AccountLockedPastTimeDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedPastTimeDenomResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedPastTimeDenomResponse) Reset()         { *m = AccountLockedPastTimeDenomResponse{} }
@@ -860,6 +926,13 @@ func (m *AccountLockedPastTimeDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedPastTimeDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type LockedDenomRequest struct {
 	Denom    string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
@@ -1040,6 +1113,8 @@ func (m *LockedResponse) GetLock() *PeriodLock {
 type AccountLockedLongerDurationRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationRequest) Reset()         { *m = AccountLockedLongerDurationRequest{} }
@@ -1089,8 +1164,17 @@ func (m *AccountLockedLongerDurationRequest) GetDuration() time.Duration {
 	return 0
 }
 
+func (m *AccountLockedLongerDurationRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationResponse) Reset()         { *m = AccountLockedLongerDurationResponse{} }
@@ -1133,9 +1217,18 @@ func (m *AccountLockedLongerDurationResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedLongerDurationResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationNotUnlockingOnlyRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Reset() {
@@ -1189,8 +1282,17 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) GetDuration() time.
 	return 0
 }
 
+func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationNotUnlockingOnlyResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Reset() {
@@ -1237,10 +1339,19 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) GetLocks() []Perio
 	return nil
 }
 
+func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationDenomRequest struct {
 	Owner    string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
 	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
 	Denom    string        `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationDenomRequest) Reset() {
@@ -1299,8 +1410,17 @@ func (m *AccountLockedLongerDurationDenomRequest) GetDenom() string {
 	return ""
 }
 
+func (m *AccountLockedLongerDurationDenomRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type AccountLockedLongerDurationDenomResponse struct {
 	Locks []PeriodLock `protobuf:"bytes,1,rep,name=locks,proto3" json:"locks"`
+	// pagination defines an pagination for the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *AccountLockedLongerDurationDenomResponse) Reset() {
@@ -1345,6 +1465,93 @@ func (m *AccountLockedLongerDurationDenomResponse) GetLocks() []PeriodLock {
 	return nil
 }
 
+func (m *AccountLockedLongerDurationDenomResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{28}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e906fda01cffd91a, []int{29}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*ModuleBalanceRequest)(nil), "osmosis.lockup.ModuleBalanceRequest")
 	proto.RegisterType((*ModuleBalanceResponse)(nil), "osmosis.lockup.ModuleBalanceResponse")
@@ -1374,95 +1581,105 @@ func init() {
 	proto.RegisterType((*AccountLockedLongerDurationNotUnlockingOnlyResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationNotUnlockingOnlyResponse")
 	proto.RegisterType((*AccountLockedLongerDurationDenomRequest)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomRequest")
 	proto.RegisterType((*AccountLockedLongerDurationDenomResponse)(nil), "osmosis.lockup.AccountLockedLongerDurationDenomResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.lockup.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.lockup.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/lockup/query.proto", fileDescriptor_e906fda01cffd91a) }
 
 var fileDescriptor_e906fda01cffd91a = []byte{
-	// 1314 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xb4, 0x4d, 0xbe, 0xdf, 0xbe, 0x90, 0x52, 0x0d, 0x69, 0x49, 0x36, 0xa9, 0x1d, 0xb6,
-	0x6d, 0x30, 0x90, 0xec, 0xe6, 0x47, 0x95, 0x96, 0x2a, 0x6d, 0x52, 0x27, 0x04, 0x82, 0x02, 0xb4,
-	0xa6, 0x50, 0xf1, 0x4b, 0xd6, 0xda, 0x9e, 0x9a, 0x55, 0xec, 0x1d, 0xd7, 0xbb, 0x06, 0x4c, 0x55,
-	0x2a, 0xb5, 0x1c, 0x39, 0x14, 0x71, 0xe1, 0x84, 0x80, 0x1b, 0x1c, 0x10, 0x17, 0x0e, 0x15, 0x77,
-	0x54, 0x71, 0x40, 0x95, 0xb8, 0x20, 0x0e, 0x29, 0x4a, 0xf8, 0x0b, 0x72, 0x42, 0xe2, 0x82, 0x76,
-	0x66, 0x76, 0x93, 0x5d, 0xef, 0x6e, 0x76, 0x6d, 0x25, 0xca, 0xc9, 0xb1, 0xdf, 0x9b, 0xf7, 0x3e,
-	0x9f, 0xcf, 0xbc, 0x7d, 0xfb, 0x5e, 0x40, 0xa2, 0x66, 0x95, 0x9a, 0xba, 0xa9, 0x56, 0x68, 0x71,
-	0xb5, 0x51, 0x53, 0x6f, 0x34, 0x48, 0xbd, 0xa9, 0xd4, 0xea, 0xd4, 0xa2, 0xf8, 0x88, 0xb0, 0x29,
-	0xdc, 0x26, 0xf5, 0x97, 0x69, 0x99, 0x32, 0x93, 0x6a, 0xff, 0xc5, 0xbd, 0xa4, 0x54, 0x91, 0xb9,
-	0xa9, 0x05, 0xcd, 0x24, 0xea, 0x07, 0x93, 0x05, 0x62, 0x69, 0x93, 0x6a, 0x91, 0xea, 0x86, 0xb0,
-	0x0f, 0x97, 0x29, 0x2d, 0x57, 0x88, 0xaa, 0xd5, 0x74, 0x55, 0x33, 0x0c, 0x6a, 0x69, 0x96, 0x4e,
-	0x0d, 0x53, 0x58, 0xd3, 0xc2, 0xca, 0xbe, 0x15, 0x1a, 0xd7, 0x55, 0x4b, 0xaf, 0x12, 0xd3, 0xd2,
-	0xaa, 0x35, 0x27, 0xbc, 0xdf, 0xa1, 0xd4, 0xa8, 0xb3, 0x08, 0xc2, 0x3e, 0xe8, 0x23, 0x60, 0x7f,
-	0x70, 0x93, 0x7c, 0x1c, 0xfa, 0x5f, 0xa1, 0xa5, 0x46, 0x85, 0x64, 0xb5, 0x8a, 0x66, 0x14, 0x49,
-	0x8e, 0xdc, 0x68, 0x10, 0xd3, 0x92, 0x3f, 0x86, 0x63, 0xbe, 0xdf, 0xcd, 0x1a, 0x35, 0x4c, 0x82,
-	0x35, 0xe8, 0xb6, 0x81, 0x9b, 0x03, 0x68, 0xe4, 0x60, 0xa6, 0x77, 0x6a, 0x50, 0xe1, 0xd4, 0x14,
-	0x9b, 0x9a, 0x22, 0xa8, 0x29, 0x0b, 0x54, 0x37, 0xb2, 0x13, 0x0f, 0xd6, 0xd2, 0x5d, 0xdf, 0x3f,
-	0x4a, 0x67, 0xca, 0xba, 0xf5, 0x7e, 0xa3, 0xa0, 0x14, 0x69, 0x55, 0x15, 0x3a, 0xf0, 0x8f, 0x71,
-	0xb3, 0xb4, 0xaa, 0x5a, 0xcd, 0x1a, 0x31, 0xd9, 0x01, 0x33, 0xc7, 0x23, 0xcb, 0x43, 0x30, 0xc8,
-	0x73, 0xaf, 0xd0, 0xe2, 0x2a, 0x29, 0x5d, 0xaa, 0xd2, 0x86, 0x61, 0x39, 0xc0, 0x6e, 0x83, 0x14,
-	0x64, 0xdc, 0x3b, 0x74, 0x2f, 0xc2, 0x89, 0x4b, 0xc5, 0xa2, 0x9d, 0xf5, 0x0d, 0xc3, 0x16, 0x52,
-	0x2b, 0x54, 0x08, 0x77, 0xe0, 0x08, 0xf1, 0x28, 0x74, 0xd3, 0x0f, 0x0d, 0x52, 0x1f, 0x40, 0x23,
-	0x28, 0x73, 0x38, 0x7b, 0x74, 0x73, 0x2d, 0xfd, 0x58, 0x53, 0xab, 0x56, 0xce, 0xcb, 0xec, 0x67,
-	0x39, 0xc7, 0xcd, 0xf2, 0x5d, 0x04, 0xa9, 0xb0, 0x48, 0x7b, 0x47, 0x67, 0x09, 0x86, 0x3d, 0x20,
-	0x74, 0xa3, 0xdc, 0x16, 0x9b, 0x3b, 0xc8, 0xa7, 0xcb, 0x56, 0xa0, 0xbd, 0x23, 0xb3, 0x00, 0x83,
-	0x02, 0x03, 0xaf, 0x8e, 0xb6, 0x98, 0xdc, 0x06, 0x29, 0x28, 0xc8, 0xde, 0xb1, 0xf8, 0x0a, 0xb9,
-	0x77, 0xc2, 0x11, 0x5c, 0xd6, 0x4c, 0xeb, 0xaa, 0x5e, 0x25, 0x09, 0x99, 0xe0, 0x37, 0xe1, 0xb0,
-	0xdb, 0x2a, 0x06, 0x0e, 0x8c, 0xa0, 0x4c, 0xef, 0x94, 0xa4, 0xf0, 0x5e, 0xa1, 0x38, 0xbd, 0x42,
-	0xb9, 0xea, 0x78, 0x64, 0x87, 0x6d, 0xc0, 0x9b, 0x6b, 0xe9, 0xa3, 0x3c, 0x96, 0x7b, 0x54, 0xbe,
-	0xf7, 0x28, 0x8d, 0x72, 0x5b, 0xa1, 0xe4, 0x6b, 0xee, 0x55, 0xfb, 0xf1, 0x09, 0x91, 0x66, 0xa0,
-	0xdb, 0x2e, 0x01, 0x47, 0x24, 0x49, 0xf1, 0x76, 0x49, 0xe5, 0x32, 0xa9, 0xeb, 0xb4, 0x64, 0x1f,
-	0xce, 0x1e, 0xb2, 0x93, 0xe6, 0xb8, 0xbb, 0xfc, 0x03, 0x82, 0xb1, 0xc0, 0xc8, 0xaf, 0xd2, 0xad,
-	0xaa, 0x7a, 0xcd, 0xa8, 0x34, 0xf7, 0x8b, 0x12, 0x65, 0x18, 0x8f, 0x89, 0xb7, 0x43, 0x65, 0xbe,
-	0x45, 0x30, 0xe2, 0x79, 0xbc, 0x48, 0x29, 0x4b, 0xae, 0xd3, 0x3a, 0xd9, 0x4f, 0x75, 0xf1, 0x0e,
-	0x3c, 0x15, 0x81, 0xb1, 0x43, 0x05, 0xee, 0x23, 0x37, 0xba, 0x57, 0xeb, 0x45, 0x62, 0xd0, 0xea,
-	0x3e, 0x91, 0x00, 0xf7, 0x43, 0x77, 0xc9, 0xc6, 0x33, 0x70, 0xd0, 0xce, 0x9f, 0xe3, 0x5f, 0xe4,
-	0x77, 0x41, 0x8e, 0x82, 0xde, 0xa1, 0x32, 0x9f, 0x00, 0xe6, 0x61, 0x3d, 0x4a, 0xb8, 0x48, 0xd0,
-	0x36, 0x24, 0x38, 0x07, 0xff, 0x77, 0x86, 0x03, 0x41, 0x7b, 0xb0, 0x85, 0xf6, 0xa2, 0x70, 0xc8,
-	0x0e, 0x09, 0xd6, 0x8f, 0x73, 0xd6, 0xce, 0x41, 0xf9, 0x4b, 0x9b, 0xb4, 0x1b, 0x47, 0x36, 0xe0,
-	0x09, 0x4f, 0x7e, 0x41, 0xe7, 0x1a, 0xf4, 0x68, 0xec, 0xed, 0x2c, 0xee, 0x62, 0xce, 0x8e, 0xf6,
-	0xe7, 0x5a, 0x7a, 0x34, 0x46, 0x3f, 0x5c, 0x36, 0xac, 0xcd, 0xb5, 0x74, 0x1f, 0xcf, 0xcb, 0xa3,
-	0xc8, 0x39, 0x11, 0x4e, 0xce, 0x40, 0x1f, 0xcf, 0xe7, 0x50, 0x7d, 0x12, 0xfe, 0x67, 0x2b, 0x91,
-	0xd7, 0x4b, 0x2c, 0xd5, 0xa1, 0x5c, 0x8f, 0xfd, 0x75, 0xb9, 0x24, 0xcf, 0xc3, 0x11, 0xc7, 0x53,
-	0x80, 0x52, 0xe0, 0x90, 0x6d, 0x63, 0x7e, 0x91, 0x12, 0xe7, 0x98, 0x9f, 0xfc, 0x35, 0xf2, 0x5d,
-	0xdd, 0x0a, 0x35, 0xca, 0xa4, 0xee, 0x48, 0x94, 0xb4, 0xec, 0x76, 0x43, 0xfe, 0xf7, 0xe0, 0x64,
-	0x24, 0xc2, 0x0e, 0xab, 0xeb, 0x47, 0x04, 0x53, 0x11, 0xf1, 0x3b, 0xed, 0xcc, 0xbb, 0xa1, 0x48,
-	0x15, 0xa6, 0x13, 0x21, 0xee, 0x50, 0xa1, 0x9f, 0x11, 0x3c, 0x1d, 0x91, 0xaf, 0xad, 0xfe, 0xb4,
-	0x0b, 0xb2, 0x84, 0xf4, 0xa6, 0x02, 0x64, 0x76, 0x06, 0xdf, 0x99, 0x42, 0x53, 0xff, 0xf6, 0x43,
-	0xf7, 0x15, 0x7b, 0x6b, 0xc2, 0x9f, 0x21, 0xe8, 0xf3, 0x2c, 0x16, 0xf8, 0x94, 0x3f, 0x48, 0xd0,
-	0x3e, 0x22, 0x9d, 0xde, 0xc1, 0x8b, 0x03, 0x94, 0x95, 0x3b, 0xbf, 0xff, 0xfd, 0xc5, 0x81, 0x0c,
-	0x1e, 0x55, 0x7d, 0x2b, 0x8f, 0xb3, 0x74, 0x55, 0xd9, 0xb1, 0x7c, 0x41, 0x24, 0xff, 0x06, 0x01,
-	0x6e, 0x5d, 0x27, 0xf0, 0x33, 0xc1, 0xd9, 0x02, 0xf6, 0x11, 0xe9, 0xd9, 0x38, 0xae, 0x02, 0xdd,
-	0x19, 0x86, 0x4e, 0xc1, 0x63, 0x3b, 0xa0, 0xe3, 0xef, 0xce, 0x3c, 0x6f, 0x77, 0xf8, 0x3e, 0x82,
-	0xe3, 0xc1, 0x7b, 0x02, 0x1e, 0xf7, 0x27, 0x8f, 0xdc, 0x4c, 0x24, 0x25, 0xae, 0xbb, 0xc0, 0x3b,
-	0xcf, 0xf0, 0x9e, 0xc7, 0xe7, 0xc2, 0xf0, 0x6a, 0xfc, 0x7c, 0xbe, 0xe1, 0x06, 0xc8, 0xb3, 0x11,
-	0x56, 0xbd, 0xc9, 0xaa, 0xf8, 0x16, 0xfe, 0x09, 0xc1, 0xb1, 0xc0, 0xad, 0x00, 0x8f, 0x45, 0x62,
-	0xf1, 0x6d, 0x21, 0xd2, 0x78, 0x4c, 0x6f, 0x01, 0x7c, 0x8e, 0x01, 0x7f, 0x1e, 0x9f, 0x8d, 0x07,
-	0x5c, 0x37, 0xca, 0x3e, 0xdc, 0xdf, 0x21, 0xc0, 0xad, 0x4b, 0x40, 0x6b, 0x5d, 0x84, 0x6e, 0x1b,
-	0xad, 0x75, 0x11, 0xbe, 0x53, 0xc8, 0xb3, 0x0c, 0xee, 0x0c, 0x3e, 0xb3, 0x13, 0x5c, 0x51, 0x18,
-	0xa1, 0x1a, 0x7b, 0xa7, 0x8b, 0x50, 0x8d, 0x03, 0xb7, 0x8a, 0x50, 0x8d, 0x83, 0x67, 0xfc, 0xf8,
-	0x1a, 0x0b, 0xd0, 0x35, 0xcd, 0xb4, 0xec, 0x39, 0xc9, 0xc5, 0xfd, 0x0f, 0x82, 0xd3, 0xb1, 0x86,
-	0x67, 0x3c, 0x1b, 0x0b, 0x59, 0xc8, 0x9b, 0x48, 0xba, 0xd0, 0xe6, 0x69, 0xc1, 0x33, 0xc7, 0x78,
-	0xae, 0xe0, 0x97, 0x13, 0xf2, 0xcc, 0x1b, 0x74, 0x7b, 0x7d, 0x51, 0xa3, 0xd2, 0x74, 0xa9, 0xff,
-	0x82, 0xdc, 0x45, 0xb5, 0x75, 0x52, 0xc6, 0x13, 0x91, 0xc5, 0x1e, 0x30, 0xf8, 0x4b, 0x93, 0x09,
-	0x4e, 0x08, 0x5a, 0x8b, 0x8c, 0xd6, 0x45, 0x3c, 0x1b, 0xef, 0x11, 0x21, 0xa5, 0x7c, 0x81, 0x05,
-	0xc9, 0x7b, 0xee, 0xf0, 0x57, 0xe4, 0x5b, 0x96, 0x3d, 0x93, 0x2d, 0x9e, 0x8c, 0x25, 0xfd, 0xf6,
-	0x17, 0xa4, 0x34, 0x95, 0xe4, 0x88, 0xe0, 0xf2, 0x02, 0xe3, 0x32, 0x87, 0x2f, 0x24, 0xbd, 0x22,
-	0xf6, 0x06, 0x74, 0xc9, 0x7c, 0x8a, 0xa0, 0x77, 0xdb, 0x20, 0x8b, 0x65, 0x3f, 0x94, 0xd6, 0x29,
-	0x5b, 0x3a, 0x19, 0xe9, 0x23, 0xf0, 0x8d, 0x31, 0x7c, 0xa3, 0xf8, 0x54, 0x18, 0x3e, 0x81, 0x8b,
-	0x8f, 0xe8, 0x77, 0x11, 0x00, 0x8f, 0x92, 0x6d, 0x2e, 0x2f, 0xe2, 0x13, 0xc1, 0x19, 0x1c, 0x00,
-	0xa9, 0x30, 0xb3, 0xc8, 0x3d, 0xc3, 0x72, 0x4f, 0x60, 0x65, 0x87, 0xdc, 0x85, 0x66, 0x5e, 0x2f,
-	0xa9, 0x37, 0xc5, 0x1c, 0x7d, 0x0b, 0xff, 0x86, 0x60, 0x28, 0x62, 0x2e, 0xc0, 0xd1, 0xf7, 0x14,
-	0x38, 0x25, 0x4b, 0xd3, 0x89, 0xce, 0x08, 0x02, 0x4b, 0x8c, 0xc0, 0x3c, 0xbe, 0x18, 0xf3, 0x72,
-	0x2b, 0x2c, 0x4c, 0xde, 0x99, 0x7a, 0xdc, 0xdb, 0xfd, 0xfc, 0x00, 0x3c, 0x97, 0x60, 0x2a, 0xc4,
-	0xd9, 0x04, 0x60, 0xc3, 0x5a, 0xcf, 0x42, 0x47, 0x31, 0x84, 0x00, 0x6f, 0x31, 0x01, 0x5e, 0xc7,
-	0x57, 0xda, 0x13, 0x20, 0xaa, 0x0f, 0x6d, 0x6c, 0xfd, 0x57, 0x21, 0x74, 0xf8, 0xc3, 0x67, 0x13,
-	0x90, 0xf0, 0x3c, 0x1b, 0xe7, 0x92, 0x1f, 0x14, 0x94, 0x57, 0x18, 0xe5, 0x25, 0xbc, 0xd8, 0x26,
-	0x65, 0xcf, 0x73, 0x9d, 0x7d, 0xe9, 0xc1, 0x7a, 0x0a, 0x3d, 0x5c, 0x4f, 0xa1, 0xbf, 0xd6, 0x53,
-	0xe8, 0xde, 0x46, 0xaa, 0xeb, 0xe1, 0x46, 0xaa, 0xeb, 0x8f, 0x8d, 0x54, 0xd7, 0xdb, 0xca, 0xb6,
-	0x55, 0x54, 0x64, 0x1a, 0xaf, 0x68, 0x05, 0xd3, 0x4d, 0xfb, 0x91, 0x93, 0x98, 0xad, 0xa5, 0x85,
-	0x1e, 0x36, 0x7b, 0x4f, 0xff, 0x17, 0x00, 0x00, 0xff, 0xff, 0xf2, 0x91, 0x08, 0x8e, 0x12, 0x18,
-	0x00, 0x00,
+	// 1452 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x98, 0xcf, 0x8f, 0x14, 0x45,
+	0x1b, 0xc7, 0xb7, 0x96, 0xdd, 0x7d, 0x5f, 0x1e, 0x04, 0x49, 0xb1, 0xe0, 0x6e, 0xb3, 0xcc, 0xac,
+	0x0d, 0x2c, 0x23, 0xee, 0x76, 0xb3, 0x0b, 0x01, 0x24, 0xfc, 0x1c, 0xd6, 0x45, 0x74, 0xd5, 0xa5,
+	0x45, 0x8d, 0x5e, 0x26, 0x3d, 0x33, 0xc5, 0xd8, 0x61, 0xa6, 0x6b, 0x98, 0xee, 0x51, 0x47, 0x82,
+	0x44, 0xf0, 0xe8, 0x01, 0xe3, 0xc5, 0xa3, 0x26, 0x6a, 0xa2, 0x5e, 0xbc, 0x68, 0xe2, 0x3f, 0x60,
+	0x88, 0x07, 0x43, 0xe2, 0xc5, 0x78, 0x58, 0x0c, 0x6b, 0x8c, 0xf1, 0xc8, 0x81, 0x70, 0x34, 0x5d,
+	0x55, 0xdd, 0x3b, 0xdd, 0xd3, 0xdd, 0xd3, 0x3d, 0xc8, 0x66, 0xc2, 0x69, 0x77, 0xba, 0x9e, 0x7a,
+	0x9e, 0xcf, 0xf7, 0x79, 0xaa, 0xab, 0xab, 0x1e, 0x90, 0xa8, 0x55, 0xa3, 0x96, 0x61, 0xa9, 0x55,
+	0x5a, 0xba, 0xd8, 0xac, 0xab, 0x97, 0x9a, 0xa4, 0xd1, 0x52, 0xea, 0x0d, 0x6a, 0x53, 0xbc, 0x49,
+	0x8c, 0x29, 0x7c, 0x4c, 0x1a, 0xad, 0xd0, 0x0a, 0x65, 0x43, 0xaa, 0xf3, 0x1f, 0xb7, 0x92, 0x32,
+	0x25, 0x66, 0xa6, 0x16, 0x75, 0x8b, 0xa8, 0x6f, 0xcf, 0x16, 0x89, 0xad, 0xcf, 0xaa, 0x25, 0x6a,
+	0x98, 0x62, 0x7c, 0xa2, 0x42, 0x69, 0xa5, 0x4a, 0x54, 0xbd, 0x6e, 0xa8, 0xba, 0x69, 0x52, 0x5b,
+	0xb7, 0x0d, 0x6a, 0x5a, 0x62, 0x34, 0x2b, 0x46, 0xd9, 0xaf, 0x62, 0xf3, 0x82, 0x6a, 0x1b, 0x35,
+	0x62, 0xd9, 0x7a, 0xad, 0xee, 0xba, 0x0f, 0x1a, 0x94, 0x9b, 0x0d, 0xe6, 0x41, 0x8c, 0xef, 0x6d,
+	0x0f, 0xcf, 0xe8, 0x3d, 0x88, 0xba, 0x5e, 0x31, 0xcc, 0x76, 0xdb, 0xf1, 0x80, 0x58, 0xe7, 0x8f,
+	0x4b, 0x19, 0x18, 0xaa, 0x10, 0x93, 0x38, 0xd2, 0xd9, 0xa8, 0xbc, 0x0d, 0x46, 0x5f, 0xa4, 0xe5,
+	0x66, 0x95, 0xe4, 0xf5, 0xaa, 0x6e, 0x96, 0x88, 0x46, 0x2e, 0x35, 0x89, 0x65, 0xcb, 0xef, 0xc1,
+	0xd6, 0xc0, 0x73, 0xab, 0x4e, 0x4d, 0x8b, 0x60, 0x1d, 0x86, 0x9d, 0x14, 0x58, 0x63, 0x68, 0x72,
+	0x5d, 0x6e, 0xc3, 0xdc, 0xb8, 0xc2, 0x29, 0x15, 0x87, 0x52, 0x11, 0x7c, 0xca, 0x69, 0x6a, 0x98,
+	0xf9, 0x7d, 0x37, 0x97, 0xb3, 0x03, 0xdf, 0xdc, 0xce, 0xe6, 0x2a, 0x86, 0xfd, 0x56, 0xb3, 0xa8,
+	0x94, 0x68, 0x4d, 0x15, 0x92, 0xf8, 0x9f, 0x19, 0xab, 0x7c, 0x51, 0xb5, 0x5b, 0x75, 0x62, 0xb1,
+	0x09, 0x96, 0xc6, 0x3d, 0xcb, 0xdb, 0x61, 0x9c, 0xc7, 0x5e, 0xa4, 0xa5, 0x8b, 0xa4, 0x7c, 0xaa,
+	0x46, 0x9b, 0xa6, 0xed, 0x82, 0x5d, 0x05, 0x29, 0x6c, 0x70, 0xed, 0xe8, 0xce, 0xc0, 0x8e, 0x53,
+	0xa5, 0x92, 0x13, 0xf5, 0x55, 0xd3, 0x49, 0xa9, 0x5e, 0xac, 0x12, 0x6e, 0xc0, 0x09, 0xf1, 0x14,
+	0x0c, 0xd3, 0x77, 0x4c, 0xd2, 0x18, 0x43, 0x93, 0x28, 0xb7, 0x3e, 0xbf, 0xf9, 0xee, 0x72, 0xf6,
+	0xb1, 0x96, 0x5e, 0xab, 0x1e, 0x91, 0xd9, 0x63, 0x59, 0xe3, 0xc3, 0xf2, 0x75, 0x04, 0x99, 0x28,
+	0x4f, 0x6b, 0x27, 0x67, 0x01, 0x26, 0x7c, 0x10, 0x86, 0x59, 0xe9, 0x49, 0xcd, 0x35, 0x04, 0x3b,
+	0x22, 0x1c, 0xad, 0x9d, 0x98, 0xd3, 0x30, 0x2e, 0x18, 0xf8, 0xea, 0xe8, 0x49, 0xc9, 0x55, 0x90,
+	0xc2, 0x9c, 0xac, 0x9d, 0x8a, 0xbf, 0x10, 0x4c, 0xf8, 0x08, 0x96, 0x74, 0xcb, 0x3e, 0x6f, 0xd4,
+	0x48, 0x4a, 0x25, 0xf8, 0x35, 0x58, 0xef, 0x6d, 0x3a, 0x63, 0x83, 0x93, 0x28, 0xb7, 0x61, 0x4e,
+	0x52, 0xf8, 0xae, 0xa3, 0xb8, 0xbb, 0x8e, 0x72, 0xde, 0xb5, 0xc8, 0x4f, 0x38, 0xc0, 0x77, 0x97,
+	0xb3, 0x9b, 0xb9, 0x2f, 0x6f, 0xaa, 0x7c, 0xe3, 0x76, 0x16, 0x69, 0xab, 0xae, 0xf0, 0x02, 0xc0,
+	0xea, 0x0e, 0x34, 0xb6, 0x8e, 0x39, 0x9e, 0xf2, 0x25, 0x82, 0x6f, 0xb6, 0x6e, 0x3a, 0x96, 0xf4,
+	0x8a, 0xcb, 0xae, 0xb5, 0xcd, 0x94, 0x3f, 0x5b, 0x5d, 0x33, 0x41, 0xa1, 0x22, 0xdb, 0x07, 0x61,
+	0xd8, 0x59, 0x4b, 0x6e, 0xb6, 0x25, 0xc5, 0xbf, 0x71, 0x2b, 0x4b, 0xa4, 0x61, 0xd0, 0xb2, 0x33,
+	0x39, 0x3f, 0xe4, 0xd0, 0x6b, 0xdc, 0x1c, 0x9f, 0xf1, 0x11, 0x72, 0xe9, 0x7b, 0xba, 0x12, 0xf2,
+	0xa0, 0x3e, 0xc4, 0x7b, 0x08, 0xa6, 0x43, 0x11, 0x5f, 0xa2, 0xab, 0xeb, 0xfc, 0x65, 0xb3, 0xda,
+	0x7a, 0xd4, 0x6a, 0xf3, 0x1d, 0x82, 0x99, 0x84, 0xc2, 0xfb, 0xa5, 0x56, 0xff, 0x20, 0x98, 0xf4,
+	0x6d, 0x41, 0xa4, 0x9c, 0x27, 0x17, 0x68, 0x83, 0x3c, 0x8a, 0xef, 0xce, 0x17, 0x08, 0x9e, 0x8c,
+	0x11, 0xdb, 0x2f, 0x35, 0xf9, 0x60, 0xd0, 0xc3, 0xf4, 0x2f, 0xa3, 0x79, 0x62, 0xd2, 0x5a, 0xbf,
+	0x14, 0x65, 0x14, 0x86, 0xcb, 0x0e, 0x0f, 0xab, 0xc7, 0x7a, 0x8d, 0xff, 0x08, 0x94, 0x6a, 0xa8,
+	0xe7, 0x52, 0x7d, 0x89, 0x40, 0x8e, 0xcb, 0x41, 0xbf, 0xd4, 0xea, 0x7d, 0xc0, 0x9c, 0xcf, 0x57,
+	0x1b, 0x2f, 0x37, 0xa8, 0x3d, 0x37, 0x1a, 0xfc, 0xdf, 0x3d, 0xae, 0x8a, 0x90, 0xe3, 0x1d, 0x85,
+	0x98, 0x17, 0x06, 0xf9, 0xed, 0xa2, 0x0e, 0x8f, 0xf3, 0x3a, 0xb8, 0x13, 0xe5, 0x4f, 0x9d, 0x32,
+	0x78, 0x7e, 0x64, 0x13, 0xb6, 0xf8, 0xe2, 0x8b, 0xbc, 0xbc, 0x0e, 0x23, 0x3a, 0x3b, 0xe5, 0x89,
+	0xd5, 0x71, 0xc2, 0xf1, 0xf6, 0xfb, 0x72, 0x76, 0x2a, 0xc1, 0x77, 0xf5, 0xac, 0x69, 0xdf, 0x5d,
+	0xce, 0x6e, 0xe4, 0x71, 0xb9, 0x17, 0x59, 0x13, 0xee, 0xe4, 0x1c, 0x6c, 0xe4, 0xf1, 0x5c, 0xa9,
+	0x4f, 0xc0, 0xff, 0x9c, 0x94, 0x16, 0x8c, 0x32, 0x0b, 0x35, 0xa4, 0x8d, 0x38, 0x3f, 0xcf, 0x96,
+	0xe5, 0x93, 0xb0, 0xc9, 0xb5, 0x14, 0x50, 0x0a, 0x0c, 0x39, 0x63, 0xcc, 0x2e, 0xb6, 0x56, 0x1a,
+	0xb3, 0x93, 0xff, 0x0e, 0xae, 0x81, 0x45, 0x6a, 0x56, 0x48, 0xc3, 0x4d, 0x51, 0xda, 0x17, 0xe1,
+	0x21, 0xa4, 0xff, 0x3f, 0xdb, 0x99, 0xbe, 0x42, 0xb0, 0x33, 0x56, 0x6a, 0xbf, 0xac, 0xf7, 0xfb,
+	0x08, 0xe6, 0x62, 0x40, 0x1f, 0xf4, 0x0b, 0xdf, 0xcf, 0x35, 0xfa, 0x01, 0xc1, 0xfe, 0x54, 0xd2,
+	0xfb, 0xa5, 0x66, 0xd7, 0x07, 0x61, 0x4f, 0x0c, 0x78, 0x4f, 0x5f, 0x95, 0x87, 0x51, 0xa8, 0x87,
+	0xfb, 0x45, 0xf9, 0x16, 0x41, 0xae, 0x7b, 0x16, 0xfa, 0xa5, 0x66, 0xa3, 0x80, 0xcf, 0x39, 0x96,
+	0x4b, 0x7a, 0x43, 0xaf, 0xb9, 0xd7, 0x31, 0xf9, 0x05, 0xd8, 0xe2, 0x7b, 0x2a, 0x68, 0x0f, 0xc0,
+	0x48, 0x9d, 0x3d, 0x11, 0x5b, 0xeb, 0xb6, 0x0e, 0x5c, 0x36, 0x2a, 0x50, 0x85, 0xed, 0xdc, 0xbd,
+	0xad, 0x30, 0xcc, 0xbc, 0xe1, 0x8f, 0x10, 0x6c, 0xf4, 0x75, 0x2e, 0xf0, 0xae, 0xa0, 0x87, 0xb0,
+	0x86, 0x87, 0xb4, 0xbb, 0x8b, 0x15, 0xc7, 0x93, 0x95, 0x6b, 0xbf, 0xfe, 0xf9, 0xc9, 0x60, 0x0e,
+	0x4f, 0xa9, 0x81, 0xb6, 0x8a, 0xdb, 0x9a, 0xa9, 0xb1, 0x69, 0x85, 0xa2, 0x08, 0xfe, 0x39, 0x02,
+	0xdc, 0xd9, 0xaf, 0xc0, 0x4f, 0x85, 0x47, 0x0b, 0x69, 0x78, 0x48, 0x7b, 0x93, 0x98, 0x0a, 0xba,
+	0x03, 0x8c, 0x4e, 0xc1, 0xd3, 0x5d, 0xe8, 0xf8, 0x79, 0xb1, 0xc0, 0xbf, 0x83, 0xf8, 0x47, 0x04,
+	0xdb, 0xc2, 0x1b, 0x11, 0x78, 0x26, 0x18, 0x3c, 0xb6, 0xf5, 0x21, 0x29, 0x49, 0xcd, 0x05, 0xef,
+	0x49, 0xc6, 0x7b, 0x04, 0x1f, 0x8e, 0xe2, 0xd5, 0xf9, 0xfc, 0x42, 0xd3, 0x73, 0x50, 0x60, 0x77,
+	0x64, 0xf5, 0x32, 0x7b, 0x75, 0xaf, 0xe0, 0xef, 0x11, 0x6c, 0x0d, 0x6d, 0x3b, 0xe0, 0xe9, 0x58,
+	0x96, 0x40, 0x9b, 0x43, 0x9a, 0x49, 0x68, 0x2d, 0xc0, 0x4f, 0x30, 0xf0, 0x67, 0xf0, 0xa1, 0x64,
+	0xe0, 0x86, 0x59, 0x09, 0x70, 0x7f, 0x8d, 0x00, 0x77, 0x76, 0x19, 0x3a, 0xd7, 0x45, 0x64, 0x3b,
+	0x43, 0xda, 0x9b, 0xc4, 0x54, 0xe0, 0x1e, 0x65, 0xb8, 0x07, 0xf1, 0x81, 0x6e, 0xb8, 0x62, 0x61,
+	0x44, 0xe6, 0xd8, 0x7f, 0x7e, 0x8d, 0xcc, 0x71, 0x68, 0xdb, 0x42, 0x9a, 0x49, 0x68, 0x9d, 0x36,
+	0xc7, 0x02, 0xba, 0xae, 0x5b, 0xb6, 0x73, 0xa4, 0xf7, 0xb8, 0xef, 0x23, 0xd8, 0x9d, 0xe8, 0x0a,
+	0x8b, 0x8f, 0x26, 0x22, 0x8b, 0x38, 0x10, 0x48, 0xc7, 0x7a, 0x9c, 0x2d, 0x74, 0x6a, 0x4c, 0xe7,
+	0x22, 0x7e, 0x3e, 0xa5, 0xce, 0x82, 0x49, 0xdb, 0xd7, 0x17, 0x35, 0xab, 0x2d, 0x4f, 0xfa, 0x4f,
+	0xc8, 0xeb, 0x84, 0x75, 0xde, 0x0e, 0xf1, 0xbe, 0xd8, 0xc5, 0x1e, 0x72, 0x6b, 0x96, 0x66, 0x53,
+	0xcc, 0x10, 0xb2, 0xe6, 0x99, 0xac, 0xe3, 0xf8, 0x68, 0xb2, 0x57, 0x84, 0x94, 0x0b, 0x45, 0xe6,
+	0xa4, 0xe0, 0xab, 0xe1, 0xcf, 0x08, 0xa4, 0xd0, 0x74, 0xb2, 0x6f, 0x1c, 0x9e, 0x4d, 0x94, 0xfa,
+	0xf6, 0x53, 0x81, 0x34, 0x97, 0x66, 0x8a, 0xd0, 0xf2, 0x2c, 0xd3, 0x72, 0x02, 0x1f, 0x4b, 0x5b,
+	0x22, 0xf6, 0xd9, 0xf7, 0xc4, 0x7c, 0x88, 0x60, 0x43, 0xdb, 0x0d, 0x07, 0xcb, 0x41, 0x94, 0xce,
+	0xeb, 0x97, 0xb4, 0x33, 0xd6, 0x46, 0xf0, 0x4d, 0x33, 0xbe, 0x29, 0xbc, 0x2b, 0x8a, 0x4f, 0x70,
+	0xf1, 0x53, 0xc8, 0x75, 0x04, 0xc0, 0xbd, 0xe4, 0x5b, 0x67, 0xe7, 0xf1, 0x8e, 0xf0, 0x08, 0x2e,
+	0x40, 0x26, 0x6a, 0x58, 0xc4, 0x3e, 0xc8, 0x62, 0xef, 0xc3, 0x4a, 0x97, 0xd8, 0xc5, 0x56, 0xc1,
+	0x28, 0xab, 0x97, 0xc5, 0x05, 0xeb, 0x0a, 0xfe, 0x05, 0xc1, 0xf6, 0x98, 0x33, 0x0c, 0x8e, 0xaf,
+	0x53, 0xe8, 0xf5, 0x49, 0xda, 0x9f, 0x6a, 0x8e, 0x10, 0xb0, 0xc0, 0x04, 0x9c, 0xc4, 0xc7, 0x13,
+	0x16, 0xb7, 0xca, 0xdc, 0x14, 0xdc, 0xa3, 0x9e, 0x57, 0xdd, 0x8f, 0x07, 0xe1, 0xe9, 0x14, 0x67,
+	0x6a, 0x9c, 0x4f, 0x01, 0x1b, 0xb5, 0xf5, 0x9c, 0x7e, 0x20, 0x1f, 0x22, 0x01, 0x6f, 0xb0, 0x04,
+	0xbc, 0x82, 0xcf, 0xf5, 0x96, 0x80, 0xb8, 0x7d, 0x68, 0x65, 0xb5, 0x25, 0x17, 0x79, 0x50, 0xc5,
+	0x87, 0x52, 0x88, 0xf0, 0xbd, 0x1b, 0x87, 0xd3, 0x4f, 0x14, 0x92, 0x17, 0x99, 0xe4, 0x05, 0x3c,
+	0xdf, 0xa3, 0x64, 0xff, 0x7b, 0xdd, 0x82, 0x11, 0x7e, 0x2a, 0xed, 0x7c, 0xa3, 0x3b, 0x0f, 0xbe,
+	0xd2, 0xce, 0x58, 0x1b, 0x01, 0x38, 0xc5, 0x00, 0x27, 0x71, 0x26, 0x0a, 0x90, 0x1f, 0x7c, 0xf3,
+	0xcf, 0xdd, 0xbc, 0x93, 0x41, 0xb7, 0xee, 0x64, 0xd0, 0x1f, 0x77, 0x32, 0xe8, 0xc6, 0x4a, 0x66,
+	0xe0, 0xd6, 0x4a, 0x66, 0xe0, 0xb7, 0x95, 0xcc, 0xc0, 0x9b, 0x4a, 0x5b, 0x7b, 0x44, 0xf8, 0x98,
+	0xa9, 0xea, 0x45, 0xcb, 0x73, 0xf8, 0xae, 0xeb, 0x92, 0xb5, 0x4a, 0x8a, 0x23, 0xec, 0xae, 0xb3,
+	0xff, 0xdf, 0x01, 0x00, 0x17, 0xde, 0xc2, 0x47, 0x38, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1507,6 +1724,8 @@ type QueryClient interface {
 	AccountLockedLongerDurationNotUnlockingOnly(ctx context.Context, in *AccountLockedLongerDurationNotUnlockingOnlyRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(ctx context.Context, in *AccountLockedLongerDurationDenomRequest, opts ...grpc.CallOption) (*AccountLockedLongerDurationDenomResponse, error)
+	// Params returns lockup params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -1643,6 +1862,15 @@ func (c *queryClient) AccountLockedLongerDurationDenom(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.lockup.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Return full balance of the module
@@ -1675,6 +1903,8 @@ type QueryServer interface {
 	AccountLockedLongerDurationNotUnlockingOnly(context.Context, *AccountLockedLongerDurationNotUnlockingOnlyRequest) (*AccountLockedLongerDurationNotUnlockingOnlyResponse, error)
 	// Returns account's locked records for a denom with longer duration
 	AccountLockedLongerDurationDenom(context.Context, *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error)
+	// Params returns lockup params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1723,6 +1953,9 @@ func (*UnimplementedQueryServer) AccountLockedLongerDurationNotUnlockingOnly(ctx
 func (*UnimplementedQueryServer) AccountLockedLongerDurationDenom(ctx context.Context, req *AccountLockedLongerDurationDenomRequest) (*AccountLockedLongerDurationDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AccountLockedLongerDurationDenom not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1980,6 +2213,24 @@ func _Query_AccountLockedLongerDurationDenom_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.lockup.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.lockup.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2040,6 +2291,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AccountLockedLongerDurationDenom",
 			Handler:    _Query_AccountLockedLongerDurationDenom_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/lockup/query.proto",
@@ -2386,12 +2641,24 @@ func (m *AccountLockedPastTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err1 != nil {
-		return 0, err1
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintQuery(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2424,6 +2691,18 @@ func (m *AccountLockedPastTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2461,12 +2740,24 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n2
-	i = encodeVarintQuery(dAtA, i, uint64(n2))
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintQuery(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2499,6 +2790,18 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) MarshalToSizedBuffer(dAt
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2536,12 +2839,24 @@ func (m *AccountUnlockedBeforeTimeRequest) MarshalToSizedBuffer(dAtA []byte) (in
 	_ = i
 	var l int
 	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err3 != nil {
-		return 0, err3
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2574,6 +2889,18 @@ func (m *AccountUnlockedBeforeTimeResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2611,6 +2938,18 @@ func (m *AccountLockedPastTimeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -2618,12 +2957,12 @@ func (m *AccountLockedPastTimeDenomRequest) MarshalToSizedBuffer(dAtA []byte) (i
 		i--
 		dAtA[i] = 0x1a
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err4 != nil {
-		return 0, err4
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n4
-	i = encodeVarintQuery(dAtA, i, uint64(n4))
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2656,6 +2995,18 @@ func (m *AccountLockedPastTimeDenomResponse) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2693,12 +3044,12 @@ func (m *LockedDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err5 != nil {
-		return 0, err5
+	n13, err13 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err13 != nil {
+		return 0, err13
 	}
-	i -= n5
-	i = encodeVarintQuery(dAtA, i, uint64(n5))
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Denom) > 0 {
@@ -2827,12 +3178,24 @@ func (m *AccountLockedLongerDurationRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err7 != nil {
-		return 0, err7
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintQuery(dAtA, i, uint64(n16))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2865,6 +3228,18 @@ func (m *AccountLockedLongerDurationResponse) MarshalToSizedBuffer(dAtA []byte)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2902,12 +3277,24 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) MarshalToSizedBuffe
 	_ = i
 	var l int
 	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err8 != nil {
-		return 0, err8
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	i -= n8
-	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	n19, err19 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -2940,6 +3327,18 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) MarshalToSizedBuff
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2977,6 +3376,18 @@ func (m *AccountLockedLongerDurationDenomRequest) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Denom) > 0 {
 		i -= len(m.Denom)
 		copy(dAtA[i:], m.Denom)
@@ -2984,12 +3395,12 @@ func (m *AccountLockedLongerDurationDenomRequest) MarshalToSizedBuffer(dAtA []by
 		i--
 		dAtA[i] = 0x1a
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
-	if err9 != nil {
-		return 0, err9
+	n22, err22 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err22 != nil {
+		return 0, err22
 	}
-	i -= n9
-	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
 	i--
 	dAtA[i] = 0x12
 	if len(m.Owner) > 0 {
@@ -3022,6 +3433,18 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Locks) > 0 {
 		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3039,6 +3462,62 @@ func (m *AccountLockedLongerDurationDenomResponse) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3194,6 +3673,10 @@ func (m *AccountLockedPastTimeRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3209,6 +3692,10 @@ func (m *AccountLockedPastTimeResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3224,6 +3711,10 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3239,6 +3730,10 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3254,6 +3749,10 @@ func (m *AccountUnlockedBeforeTimeRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3269,6 +3768,10 @@ func (m *AccountUnlockedBeforeTimeResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3288,6 +3791,10 @@ func (m *AccountLockedPastTimeDenomRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3303,6 +3810,10 @@ func (m *AccountLockedPastTimeDenomResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3369,6 +3880,10 @@ func (m *AccountLockedLongerDurationRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3384,6 +3899,10 @@ func (m *AccountLockedLongerDurationResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3399,6 +3918,10 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Size() (n int) {
 	}
 	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
 	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3414,6 +3937,10 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3433,6 +3960,10 @@ func (m *AccountLockedLongerDurationDenomRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -3448,6 +3979,30 @@ func (m *AccountLockedLongerDurationDenomResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
@@ -4317,6 +4872,42 @@ func (m *AccountLockedPastTimeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4401,6 +4992,42 @@ func (m *AccountLockedPastTimeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4516,6 +5143,42 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyRequest) Unmarshal(dAtA []byte) er
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4600,17 +5263,53 @@ func (m *AccountLockedPastTimeNotUnlockingOnlyResponse) Unmarshal(dAtA []byte) e
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -4715,6 +5414,42 @@ func (m *AccountUnlockedBeforeTimeRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4799,6 +5534,42 @@ func (m *AccountUnlockedBeforeTimeResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4946,6 +5717,42 @@ func (m *AccountLockedPastTimeDenomRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5030,6 +5837,42 @@ func (m *AccountLockedPastTimeDenomResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5499,6 +6342,42 @@ func (m *AccountLockedLongerDurationRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5583,6 +6462,42 @@ func (m *AccountLockedLongerDurationResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5698,6 +6613,42 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyRequest) Unmarshal(dAtA []by
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5782,6 +6733,42 @@ func (m *AccountLockedLongerDurationNotUnlockingOnlyResponse) Unmarshal(dAtA []b
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5929,6 +6916,42 @@ func (m *AccountLockedLongerDurationDenomRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6013,6 +7036,175 @@ func (m *AccountLockedLongerDurationDenomResponse) Unmarshal(dAtA []byte) error
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
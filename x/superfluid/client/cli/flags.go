@@ -4,3 +4,8 @@ package cli
 const (
 	FlagSuperfluidAssets = "superfluid-assets"
 )
+
+// JoinSwapLockAndSuperfluidDelegate flags
+const (
+	FlagValAddr = "val-addr"
+)
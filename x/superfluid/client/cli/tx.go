@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -29,6 +30,7 @@ func GetTxCmd() *cobra.Command {
 		NewSuperfluidDelegateCmd(),
 		NewSuperfluidUndelegateCmd(),
 		NewSuperfluidRedelegateCmd(),
+		NewJoinSwapLockAndSuperfluidDelegateCmd(),
 	)
 
 	return cmd
@@ -142,6 +144,63 @@ func NewSuperfluidRedelegateCmd() *cobra.Command {
 	return cmd
 }
 
+// NewJoinSwapLockAndSuperfluidDelegateCmd broadcast MsgJoinSwapLockAndSuperfluidDelegate
+func NewJoinSwapLockAndSuperfluidDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "join-swap-lock [pool_id] [token_in] [share_out_min_amount] [duration] [flags]",
+		Short: "join a pool with a single token, lock the shares, and optionally superfluid delegate the lock",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			poolId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			tokenIn, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			shareOutMinAmount, ok := sdk.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid share out min amount: %s", args[2])
+			}
+
+			duration, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+
+			valAddr, err := cmd.Flags().GetString(FlagValAddr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgJoinSwapLockAndSuperfluidDelegate(
+				clientCtx.GetFromAddress(),
+				poolId,
+				tokenIn,
+				shareOutMinAmount,
+				duration,
+				valAddr,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().String(FlagValAddr, "", "validator to superfluid delegate the new lock to, skipped if empty")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 // NewCmdSubmitSetSuperfluidAssetsProposal implements a command handler for submitting a superfluid asset set proposal transaction.
 func NewCmdSubmitSetSuperfluidAssetsProposal() *cobra.Command {
 	cmd := &cobra.Command{
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
 	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
@@ -103,6 +104,14 @@ func (h Hooks) OnStartUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uin
 	}
 }
 
+// OnCancelUnlock leaves the lock undelegated. Starting to unlock superfluid undelegated the lock,
+// and its unbonding synthetic lockup prevents cancelling the unlock until the unbonding finishes.
+// A rolling lock relocked meanwhile keeps the synthetic lockup until it matures. Either way the lock
+// then has no superfluid state left, and the owner can superfluid delegate it again.
+func (h Hooks) OnCancelUnlock(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration) {
+
+}
+
 func (h Hooks) OnTokenUnlocked(ctx sdk.Context, address sdk.AccAddress, lockID uint64, amount sdk.Coins, lockDuration time.Duration, unlockTime time.Time) {
 
 }
@@ -111,6 +120,29 @@ func (h Hooks) OnTokenSlashed(ctx sdk.Context, lockID uint64, amount sdk.Coins)
 
 }
 
+// OnLockupExtend leaves superfluid delegations as is, as lengthening a lock keeps it eligible for superfluid staking.
+func (h Hooks) OnLockupExtend(ctx sdk.Context, lockID uint64, previousDuration, newDuration time.Duration) {
+
+}
+
+// OnLockSplit needs no handling, as locks with synthetic lockups, i.e. superfluid delegated locks, are never split.
+func (h Hooks) OnLockSplit(ctx sdk.Context, address sdk.AccAddress, lockID uint64, splitLockID uint64, splitAmount sdk.Coins) {
+
+}
+
+// OnLocksMerged needs no handling, as locks with synthetic lockups, i.e. superfluid delegated locks, are never merged.
+func (h Hooks) OnLocksMerged(ctx sdk.Context, address sdk.AccAddress, lockID uint64, mergedLockIDs []uint64, mergedAmount sdk.Coins) {
+
+}
+
+// BeforeLockTransfer rejects transfers of superfluid delegated locks, as the delegation is made on behalf of the lock owner.
+func (h Hooks) BeforeLockTransfer(ctx sdk.Context, lockID uint64, previousOwner, newOwner sdk.AccAddress) error {
+	if !h.k.GetLockIdIntermediaryAccountConnection(ctx, lockID).Empty() {
+		return sdkerrors.Wrapf(types.ErrAlreadyUsedSuperfluidLockup, "cannot transfer lock %d", lockID)
+	}
+	return nil
+}
+
 // staking hooks
 func (h Hooks) AfterValidatorCreated(ctx sdk.Context, valAddr sdk.ValAddress)   {}
 func (h Hooks) BeforeValidatorModified(ctx sdk.Context, valAddr sdk.ValAddress) {}
@@ -6,6 +6,7 @@ import (
 	appparams "github.com/osmosis-labs/osmosis/app/params"
 	"github.com/osmosis-labs/osmosis/x/gamm/pool-models/balancer"
 	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/x/mint/types"
 	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
 	"github.com/tendermint/tendermint/crypto/ed25519"
@@ -205,6 +206,88 @@ func (suite *KeeperTestSuite) TestOnStartUnlock() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestRollingLockRelockedWhileUnbonding() {
+	suite.SetupTest()
+
+	poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
+	suite.Require().Equal(poolId, uint64(1))
+
+	// setup a superfluid delegation of a rolling lock
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	_, locks := suite.SetupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, "gamm/pool/1"}})
+	lock := locks[0]
+	err := suite.app.LockupKeeper.SetLockRolling(suite.ctx, lock, true)
+	suite.Require().NoError(err)
+
+	// the undelegation unbonds for the lock duration, so it matures along with the lock
+	rollingLock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lock.ID)
+	suite.Require().NoError(err)
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, *rollingLock)
+	suite.Require().NoError(err)
+	valAddr := valAddrs[0].String()
+	synthLock, err := suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, lock.ID, keeper.UnstakingSuffix(valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(synthLock.EndTime, suite.ctx.BlockTime().Add(lock.Duration))
+
+	// withdraw the lock while its undelegation is still unbonding
+	ctx := suite.ctx.WithBlockTime(synthLock.EndTime).WithEventManager(sdk.NewEventManager())
+	suite.Require().NotPanics(func() {
+		suite.app.LockupKeeper.WithdrawAllMaturedLocks(ctx)
+	})
+
+	// check the lock is relocked, and stays undelegated while the undelegation is unbonding
+	relockedLock, err := suite.app.LockupKeeper.GetLockByID(ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(relockedLock.IsUnlocking())
+	suite.Require().Equal(lock.Coins, relockedLock.Coins)
+	_, err = suite.app.LockupKeeper.GetSyntheticLockup(ctx, lock.ID, keeper.UnstakingSuffix(valAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal("", suite.app.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(ctx, lock.ID).String())
+	relocked := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == lockuptypes.TypeEvtRelock {
+			relocked = true
+		}
+	}
+	suite.Require().True(relocked)
+
+	// check the undelegation still finishes unbonding, and the lock stays locked
+	suite.app.LockupKeeper.DeleteAllMaturedSyntheticLocks(ctx)
+	_, err = suite.app.LockupKeeper.GetSyntheticLockup(ctx, lock.ID, keeper.UnstakingSuffix(valAddr))
+	suite.Require().Error(err)
+	relockedLock, err = suite.app.LockupKeeper.GetLockByID(ctx, lock.ID)
+	suite.Require().NoError(err)
+	suite.Require().False(relockedLock.IsUnlocking())
+}
+
+func (suite *KeeperTestSuite) TestBeforeLockTransfer() {
+	suite.SetupTest()
+
+	poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
+	suite.Require().Equal(poolId, uint64(1))
+
+	// setup validators and superfluid delegation
+	valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+	_, locks := suite.SetupSuperfluidDelegations(valAddrs, []superfluidDelegation{{0, "gamm/pool/1"}})
+	lock := locks[0]
+	owner, err := sdk.AccAddressFromBech32(lock.Owner)
+	suite.Require().NoError(err)
+	newOwner := sdk.AccAddress([]byte("newOwner------------"))
+
+	// superfluid delegated lock can't be transferred
+	_, err = suite.app.LockupKeeper.TransferLockByID(suite.ctx, owner, lock.ID, newOwner)
+	suite.Require().Error(err)
+
+	// lock can be transferred once undelegated, along with the unbonding synthetic lockup
+	err = suite.app.LockupKeeper.BeginUnlock(suite.ctx, lock)
+	suite.Require().NoError(err)
+	_, err = suite.app.LockupKeeper.TransferLockByID(suite.ctx, owner, lock.ID, newOwner)
+	suite.Require().NoError(err)
+	synthLock, err := suite.app.LockupKeeper.GetSyntheticLockup(suite.ctx, lock.ID, keeper.UnstakingSuffix(valAddrs[0].String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(newOwner.String(), synthLock.Owner)
+}
+
 func (suite *KeeperTestSuite) TestBeforeSlashingUnbondingDelegationHook() {
 	testCases := []struct {
 		name                  string
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/x/superfluid/types"
@@ -40,3 +41,24 @@ func (server msgServer) SuperfluidRedelegate(goCtx context.Context, msg *types.M
 	err := server.keeper.SuperfluidRedelegate(ctx, msg.Sender, msg.LockId, msg.NewValAddr)
 	return &types.MsgSuperfluidRedelegateResponse{}, err
 }
+
+func (server msgServer) JoinSwapLockAndSuperfluidDelegate(goCtx context.Context, msg *types.MsgJoinSwapLockAndSuperfluidDelegate) (*types.MsgJoinSwapLockAndSuperfluidDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	lockId, shareOutAmount, err := server.keeper.JoinSwapLockAndSuperfluidDelegate(ctx, msg.Sender, msg.PoolId, msg.TokenIn, msg.ShareOutMinAmount, msg.Duration, msg.ValAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtJoinSwapLock,
+			sdk.NewAttribute(types.AttributePoolId, strconv.FormatUint(msg.PoolId, 10)),
+			sdk.NewAttribute(types.AttributeLockId, strconv.FormatUint(lockId, 10)),
+			sdk.NewAttribute(types.AttributeShareOutAmount, shareOutAmount.String()),
+			sdk.NewAttribute(types.AttributeValidator, msg.ValAddr),
+		),
+	})
+
+	return &types.MsgJoinSwapLockAndSuperfluidDelegateResponse{LockId: lockId, ShareOutAmount: shareOutAmount}, nil
+}
@@ -7,6 +7,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/x/mint/types"
 	"github.com/osmosis-labs/osmosis/x/superfluid/types"
@@ -353,3 +354,35 @@ func (k Keeper) SuperfluidRedelegate(ctx sdk.Context, sender string, lockID uint
 // Eugen’s point: Only rewards message needs to be updated. Rest of messages are fine
 // Queries need to be updated
 // We can do this at the very end though, since it just relates to queries.
+
+// JoinSwapLockAndSuperfluidDelegate joins the pool with a single token, locks the
+// resulting pool shares in a new lockup of the given duration, and superfluid
+// delegates that lockup to valAddr. The delegation is skipped if valAddr is empty.
+func (k Keeper) JoinSwapLockAndSuperfluidDelegate(ctx sdk.Context, sender string, poolId uint64, tokenIn sdk.Coin, shareOutMinAmount sdk.Int, duration time.Duration, valAddr string) (uint64, sdk.Int, error) {
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	shareOutAmount, err := k.gk.JoinSwapExternAmountIn(ctx, senderAddr, poolId, tokenIn, shareOutMinAmount)
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	// always create a new lockup rather than adding to an existing one,
+	// so the new lockup is guaranteed not to be superfluid delegated already.
+	shares := sdk.NewCoins(sdk.NewCoin(gammtypes.GetPoolShareDenom(poolId), shareOutAmount))
+	lock, err := k.lk.LockTokens(ctx, senderAddr, shares, duration)
+	if err != nil {
+		return 0, sdk.Int{}, err
+	}
+
+	if valAddr != "" {
+		err = k.SuperfluidDelegate(ctx, sender, lock.ID, valAddr)
+		if err != nil {
+			return 0, sdk.Int{}, err
+		}
+	}
+
+	return lock.ID, shareOutAmount, nil
+}
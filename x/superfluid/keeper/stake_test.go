@@ -8,6 +8,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	appparams "github.com/osmosis-labs/osmosis/app/params"
 	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/x/lockup/types"
 	minttypes "github.com/osmosis-labs/osmosis/x/mint/types"
 	"github.com/osmosis-labs/osmosis/x/superfluid/keeper"
@@ -158,6 +159,80 @@ func (suite *KeeperTestSuite) SetupSuperfluidDelegate(valAddr sdk.ValAddress, de
 	return lock
 }
 
+func (suite *KeeperTestSuite) TestJoinSwapLockAndSuperfluidDelegate() {
+	testCases := []struct {
+		name              string
+		delegate          bool
+		shareOutMinAmount sdk.Int
+		expectPass        bool
+	}{
+		{"join, lock and superfluid delegate", true, sdk.OneInt(), true},
+		{"join and lock without superfluid delegation", false, sdk.OneInt(), true},
+		{"share out amount lower than min amount", true, sdk.NewIntWithDecimal(1, 30), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			poolId := suite.createGammPool([]string{appparams.BaseCoinUnit, "foo"})
+			denom := gammtypes.GetPoolShareDenom(poolId)
+			valAddrs := suite.SetupValidators([]stakingtypes.BondStatus{stakingtypes.Bonded})
+
+			// register the LP token as a superfluid asset with an OSMO TWAP price
+			suite.app.SuperfluidKeeper.SetSuperfluidAsset(suite.ctx, types.SuperfluidAsset{
+				Denom:     denom,
+				AssetType: types.SuperfluidAssetTypeLPShare,
+			})
+			suite.app.SuperfluidKeeper.SetEpochOsmoEquivalentTWAP(suite.ctx, 1, denom, sdk.NewDec(20))
+			params := suite.app.SuperfluidKeeper.GetParams(suite.ctx)
+			suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epochstypes.EpochInfo{
+				Identifier:   params.RefreshEpochIdentifier,
+				CurrentEpoch: 2,
+			})
+			suite.app.IncentivesKeeper.SetLockableDurations(suite.ctx, []time.Duration{params.UnbondingDuration})
+
+			addr := sdk.AccAddress([]byte("addr1---------------"))
+			tokenIn := sdk.NewInt64Coin("foo", 1000000)
+			err := suite.app.BankKeeper.MintCoins(suite.ctx, minttypes.ModuleName, sdk.Coins{tokenIn})
+			suite.Require().NoError(err)
+			err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, minttypes.ModuleName, addr, sdk.Coins{tokenIn})
+			suite.Require().NoError(err)
+
+			valAddr := ""
+			if tc.delegate {
+				valAddr = valAddrs[0].String()
+			}
+			lockId, shareOutAmount, err := suite.app.SuperfluidKeeper.JoinSwapLockAndSuperfluidDelegate(
+				suite.ctx, addr.String(), poolId, tokenIn, tc.shareOutMinAmount, params.UnbondingDuration, valAddr)
+			if !tc.expectPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(shareOutAmount.IsPositive())
+
+			// check the shares are locked in the returned lock
+			lock, err := suite.app.LockupKeeper.GetLockByID(suite.ctx, lockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(addr.String(), lock.Owner)
+			suite.Require().Equal(sdk.Coins{sdk.NewCoin(denom, shareOutAmount)}, lock.Coins)
+			suite.Require().Equal(params.UnbondingDuration, lock.Duration)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, addr).IsZero())
+
+			// check the lock is superfluid delegated only when a validator is given
+			intermediaryAcc := suite.app.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(suite.ctx, lockId)
+			if tc.delegate {
+				expAcc := types.NewSuperfluidIntermediaryAccount(denom, valAddr, 0)
+				suite.Require().Equal(expAcc.GetAccAddress().String(), intermediaryAcc.String())
+			} else {
+				suite.Require().True(intermediaryAcc.Empty())
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestSuperfluidDelegate() {
 	testCases := []struct {
 		name               string
@@ -5,7 +5,12 @@ const (
 	TypeEvtSetSuperfluidAsset    = "set_superfluid_asset"
 	TypeEvtAddSuperfluidAsset    = "add_superfluid_asset"
 	TypeEvtRemoveSuperfluidAsset = "remove_superfluid_asset"
+	TypeEvtJoinSwapLock          = "join_swap_lock"
 
 	AttributeDenom               = "denom"
 	AttributeSuperfluidAssetType = "superfluid_asset_type"
+	AttributePoolId              = "pool_id"
+	AttributeLockId              = "lock_id"
+	AttributeShareOutAmount      = "share_out_amount"
+	AttributeValidator           = "validator"
 )
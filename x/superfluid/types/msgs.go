@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,6 +12,8 @@ const (
 	TypeMsgSuperfluidDelegate   = "superfluid_delegate"
 	TypeMsgSuperfluidUndelegate = "superfluid_undelegate"
 	TypeMsgSuperfluidRedelegate = "superfluid_redelegate"
+
+	TypeMsgJoinSwapLockAndSuperfluidDelegate = "join_swap_lock_and_superfluid_delegate"
 )
 
 var _ sdk.Msg = &MsgSuperfluidDelegate{}
@@ -107,3 +110,53 @@ func (m MsgSuperfluidRedelegate) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgJoinSwapLockAndSuperfluidDelegate{}
+
+// NewMsgJoinSwapLockAndSuperfluidDelegate creates a message to join a pool with a single token,
+// lock the shares and superfluid delegate the lockup. An empty valAddr skips the delegation.
+func NewMsgJoinSwapLockAndSuperfluidDelegate(sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, shareOutMinAmount sdk.Int, duration time.Duration, valAddr string) *MsgJoinSwapLockAndSuperfluidDelegate {
+	return &MsgJoinSwapLockAndSuperfluidDelegate{
+		Sender:            sender.String(),
+		PoolId:            poolId,
+		TokenIn:           tokenIn,
+		ShareOutMinAmount: shareOutMinAmount,
+		Duration:          duration,
+		ValAddr:           valAddr,
+	}
+}
+
+func (m MsgJoinSwapLockAndSuperfluidDelegate) Route() string { return RouterKey }
+func (m MsgJoinSwapLockAndSuperfluidDelegate) Type() string {
+	return TypeMsgJoinSwapLockAndSuperfluidDelegate
+}
+func (m MsgJoinSwapLockAndSuperfluidDelegate) ValidateBasic() error {
+	if m.Sender == "" {
+		return fmt.Errorf("sender should not be an empty address")
+	}
+	if m.PoolId == 0 {
+		return fmt.Errorf("pool id should be positive: %d", m.PoolId)
+	}
+	if !m.TokenIn.IsValid() || !m.TokenIn.IsPositive() {
+		return fmt.Errorf("token in should be a valid positive coin: %s", m.TokenIn)
+	}
+	if m.ShareOutMinAmount.IsNil() || !m.ShareOutMinAmount.IsPositive() {
+		return fmt.Errorf("share out min amount should be positive: %s", m.ShareOutMinAmount)
+	}
+	if m.Duration <= 0 {
+		return fmt.Errorf("duration should be positive: %d < 0", m.Duration)
+	}
+	if m.ValAddr != "" {
+		if _, err := sdk.ValAddressFromBech32(m.ValAddr); err != nil {
+			return fmt.Errorf("invalid validator address: %s", err)
+		}
+	}
+	return nil
+}
+func (m MsgJoinSwapLockAndSuperfluidDelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+func (m MsgJoinSwapLockAndSuperfluidDelegate) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -6,10 +6,12 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -17,12 +19,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -310,6 +314,135 @@ func (m *MsgSuperfluidRedelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSuperfluidRedelegateResponse proto.InternalMessageInfo
 
+// MsgJoinSwapLockAndSuperfluidDelegate joins a pool with token_in, locks the
+// resulting shares for duration, and superfluid delegates the new lockup to
+// val_addr. The superfluid delegation is skipped when val_addr is empty.
+type MsgJoinSwapLockAndSuperfluidDelegate struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId            uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn           types.Coin                             `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	Duration          time.Duration                          `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	ValAddr           string                                 `protobuf:"bytes,6,opt,name=val_addr,json=valAddr,proto3" json:"val_addr,omitempty" yaml:"val_addr"`
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) Reset()         { *m = MsgJoinSwapLockAndSuperfluidDelegate{} }
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgJoinSwapLockAndSuperfluidDelegate) ProtoMessage()    {}
+func (*MsgJoinSwapLockAndSuperfluidDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{6}
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegate.Merge(m, src)
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegate proto.InternalMessageInfo
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) GetValAddr() string {
+	if m != nil {
+		return m.ValAddr
+	}
+	return ""
+}
+
+type MsgJoinSwapLockAndSuperfluidDelegateResponse struct {
+	LockId         uint64                                 `protobuf:"varint,1,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) Reset() {
+	*m = MsgJoinSwapLockAndSuperfluidDelegateResponse{}
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgJoinSwapLockAndSuperfluidDelegateResponse) ProtoMessage() {}
+func (*MsgJoinSwapLockAndSuperfluidDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_55b645f187d22814, []int{7}
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegateResponse.Merge(m, src)
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgJoinSwapLockAndSuperfluidDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*MsgSuperfluidDelegate)(nil), "osmosis.superfluid.MsgSuperfluidDelegate")
 	proto.RegisterType((*MsgSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidDelegateResponse")
@@ -317,38 +450,58 @@ func init() {
 	proto.RegisterType((*MsgSuperfluidUndelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidUndelegateResponse")
 	proto.RegisterType((*MsgSuperfluidRedelegate)(nil), "osmosis.superfluid.MsgSuperfluidRedelegate")
 	proto.RegisterType((*MsgSuperfluidRedelegateResponse)(nil), "osmosis.superfluid.MsgSuperfluidRedelegateResponse")
+	proto.RegisterType((*MsgJoinSwapLockAndSuperfluidDelegate)(nil), "osmosis.superfluid.MsgJoinSwapLockAndSuperfluidDelegate")
+	proto.RegisterType((*MsgJoinSwapLockAndSuperfluidDelegateResponse)(nil), "osmosis.superfluid.MsgJoinSwapLockAndSuperfluidDelegateResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/superfluid/tx.proto", fileDescriptor_55b645f187d22814) }
 
 var fileDescriptor_55b645f187d22814 = []byte{
-	// 413 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x31, 0x6f, 0xda, 0x40,
-	0x18, 0xc5, 0x50, 0x41, 0x7b, 0x6a, 0x87, 0x9e, 0xa8, 0xa0, 0xae, 0x6a, 0xa8, 0xbb, 0x50, 0x55,
-	0xf5, 0x09, 0xd8, 0xba, 0x15, 0x75, 0xa9, 0x54, 0x16, 0x57, 0xcd, 0x10, 0x29, 0x42, 0x67, 0xdf,
-	0x71, 0xb1, 0x72, 0xf8, 0x2c, 0x9f, 0x6d, 0x60, 0xca, 0x5f, 0xc8, 0xcf, 0xca, 0xc8, 0x98, 0x29,
-	0x8a, 0x60, 0xce, 0x92, 0x5f, 0x10, 0x61, 0x6c, 0x1c, 0xc0, 0x11, 0x44, 0x62, 0xbb, 0xef, 0xde,
-	0xd3, 0x7b, 0x4f, 0xef, 0xb3, 0x0f, 0x7c, 0x12, 0x72, 0x24, 0xa4, 0x23, 0x91, 0x0c, 0x3d, 0xea,
-	0x0f, 0x79, 0xe8, 0x10, 0x14, 0x4c, 0x0c, 0xcf, 0x17, 0x81, 0x80, 0x30, 0x01, 0x8d, 0x0c, 0x54,
-	0xab, 0x4c, 0x30, 0x11, 0xc3, 0x68, 0x79, 0x5a, 0x31, 0x55, 0x8d, 0x09, 0xc1, 0x38, 0x45, 0xf1,
-	0x64, 0x85, 0x43, 0x44, 0x42, 0x1f, 0x07, 0x8e, 0x70, 0x53, 0xdc, 0x8e, 0xa5, 0x90, 0x85, 0x25,
-	0x45, 0x51, 0xdb, 0xa2, 0x01, 0x6e, 0x23, 0x5b, 0x38, 0x29, 0xfe, 0x35, 0x27, 0x46, 0x76, 0x5c,
-	0x91, 0xf4, 0x08, 0x7c, 0xe8, 0x4b, 0xf6, 0x6f, 0x7d, 0xfd, 0x9b, 0x72, 0xca, 0x70, 0x40, 0xe1,
-	0x37, 0x50, 0x96, 0xd4, 0x25, 0xd4, 0xaf, 0x2b, 0x4d, 0xa5, 0xf5, 0xa6, 0xf7, 0xfe, 0xe1, 0xb6,
-	0xf1, 0x6e, 0x8a, 0x47, 0xfc, 0xa7, 0xbe, 0xba, 0xd7, 0xcd, 0x84, 0x00, 0x6b, 0xa0, 0xc2, 0x85,
-	0x7d, 0x31, 0x70, 0x48, 0xbd, 0xd8, 0x54, 0x5a, 0xaf, 0xcc, 0xf2, 0x72, 0xfc, 0x43, 0xe0, 0x47,
-	0xf0, 0x3a, 0xc2, 0x7c, 0x80, 0x09, 0xf1, 0xeb, 0xa5, 0xa5, 0x8a, 0x59, 0x89, 0x30, 0xff, 0x45,
-	0x88, 0xaf, 0x37, 0xc0, 0xe7, 0x5c, 0x5f, 0x93, 0x4a, 0x4f, 0xb8, 0x92, 0xea, 0x67, 0xa0, 0xb6,
-	0x41, 0xf8, 0xef, 0x92, 0x23, 0x46, 0xd3, 0xbf, 0x80, 0xc6, 0x33, 0xf2, 0xeb, 0x04, 0x97, 0x5b,
-	0x09, 0x4c, 0x7a, 0xcc, 0x04, 0xb0, 0x09, 0xde, 0xba, 0x74, 0x3c, 0xd8, 0x2a, 0x08, 0xb8, 0x74,
-	0x7c, 0x92, 0x74, 0xb4, 0x9d, 0x31, 0x0b, 0x90, 0x66, 0xec, 0xdc, 0x17, 0x41, 0xa9, 0x2f, 0x19,
-	0xf4, 0x01, 0xcc, 0xdb, 0xa1, 0xb1, 0xfb, 0xb1, 0x19, 0xb9, 0xb5, 0xab, 0xed, 0x83, 0xa9, 0xa9,
-	0x37, 0x9c, 0x80, 0x6a, 0xee, 0x7a, 0xbe, 0xef, 0x95, 0xca, 0xc8, 0x6a, 0xf7, 0x05, 0xe4, 0x7c,
-	0xe7, 0x27, 0x6b, 0xd9, 0xef, 0x9c, 0x91, 0x0f, 0x70, 0xde, 0xed, 0xbb, 0xf7, 0xf7, 0x7a, 0xae,
-	0x29, 0xb3, 0xb9, 0xa6, 0xdc, 0xcd, 0x35, 0xe5, 0x6a, 0xa1, 0x15, 0x66, 0x0b, 0xad, 0x70, 0xb3,
-	0xd0, 0x0a, 0xa7, 0x1d, 0xe6, 0x04, 0xe7, 0xa1, 0x65, 0xd8, 0x62, 0x84, 0x12, 0xe1, 0x1f, 0x1c,
-	0x5b, 0x32, 0x1d, 0xd0, 0x64, 0xe3, 0x39, 0x98, 0x7a, 0x54, 0x5a, 0xe5, 0xf8, 0x1f, 0xec, 0x3e,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x29, 0x6d, 0x31, 0x31, 0x04, 0x00, 0x00,
+	// 695 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x81, 0x9b, 0x70, 0xe7, 0xde, 0xd2, 0x32, 0xa5, 0x4a, 0x08, 0xaa, 0x0d, 0x6e, 0x55,
+	0x51, 0x01, 0xb6, 0x02, 0x9b, 0xaa, 0xab, 0x92, 0xb2, 0x49, 0x45, 0x54, 0xc9, 0xa8, 0x5d, 0x54,
+	0xaa, 0x2c, 0x27, 0x33, 0x18, 0x2b, 0xf6, 0x8c, 0xe5, 0x19, 0x07, 0xd8, 0xb4, 0x8f, 0xd0, 0x2e,
+	0xbb, 0xeb, 0x8b, 0xf4, 0x01, 0x58, 0xb2, 0xac, 0xba, 0x70, 0x2b, 0x58, 0x54, 0xea, 0x32, 0x4f,
+	0x50, 0xf9, 0x67, 0x62, 0x08, 0x46, 0x90, 0x96, 0x55, 0x66, 0xe6, 0xfc, 0x7d, 0xe7, 0xf3, 0x77,
+	0x4e, 0xc0, 0x02, 0x65, 0x1e, 0x65, 0x0e, 0xd3, 0x59, 0xe8, 0xe3, 0x60, 0xd7, 0x0d, 0x1d, 0xa4,
+	0xf3, 0x03, 0xcd, 0x0f, 0x28, 0xa7, 0x10, 0x66, 0x46, 0x2d, 0x37, 0xd6, 0xe7, 0x6c, 0x6a, 0xd3,
+	0xc4, 0xac, 0xc7, 0xa7, 0xd4, 0xb3, 0x2e, 0xdb, 0x94, 0xda, 0x2e, 0xd6, 0x93, 0x5b, 0x27, 0xdc,
+	0xd5, 0x51, 0x18, 0x58, 0xdc, 0xa1, 0x44, 0xd8, 0xbb, 0x49, 0x2a, 0xbd, 0x63, 0x31, 0xac, 0xf7,
+	0x1b, 0x1d, 0xcc, 0xad, 0x86, 0xde, 0xa5, 0x8e, 0xb0, 0x3f, 0x28, 0x80, 0x91, 0x1f, 0x53, 0x27,
+	0xb5, 0x0f, 0xee, 0xb5, 0x99, 0xbd, 0x33, 0x7c, 0xde, 0xc2, 0x2e, 0xb6, 0x2d, 0x8e, 0xe1, 0x63,
+	0x50, 0x66, 0x98, 0x20, 0x1c, 0xd4, 0xa4, 0x45, 0x69, 0xf9, 0xdf, 0xe6, 0xec, 0x20, 0x52, 0x6e,
+	0x1d, 0x5a, 0x9e, 0xfb, 0x54, 0x4d, 0xdf, 0x55, 0x23, 0x73, 0x80, 0x55, 0x50, 0x71, 0x69, 0xb7,
+	0x67, 0x3a, 0xa8, 0x36, 0xb1, 0x28, 0x2d, 0x4f, 0x19, 0xe5, 0xf8, 0xda, 0x42, 0x70, 0x1e, 0x4c,
+	0xf7, 0x2d, 0xd7, 0xb4, 0x10, 0x0a, 0x6a, 0x93, 0x71, 0x16, 0xa3, 0xd2, 0xb7, 0xdc, 0x4d, 0x84,
+	0x02, 0x55, 0x01, 0xf7, 0x0b, 0xeb, 0x1a, 0x98, 0xf9, 0x94, 0x30, 0xac, 0xbe, 0x05, 0xd5, 0x73,
+	0x0e, 0xaf, 0x08, 0xba, 0x41, 0x68, 0xea, 0x12, 0x50, 0x2e, 0x49, 0x3f, 0x44, 0xf0, 0x7e, 0x04,
+	0x81, 0x81, 0x6f, 0x12, 0x01, 0x5c, 0x04, 0xff, 0x13, 0xbc, 0x6f, 0x8e, 0x10, 0x04, 0x08, 0xde,
+	0x7f, 0x9d, 0x71, 0x34, 0x8a, 0xd1, 0xc0, 0x17, 0x30, 0xfe, 0x9c, 0x04, 0x0f, 0xdb, 0xcc, 0x7e,
+	0x41, 0x1d, 0xb2, 0xb3, 0x6f, 0xf9, 0xdb, 0xb4, 0xdb, 0xdb, 0x24, 0xe8, 0xef, 0x3e, 0xe7, 0x0a,
+	0xa8, 0xf8, 0x94, 0xba, 0x43, 0xc4, 0x4d, 0x38, 0x88, 0x94, 0x99, 0xd4, 0x37, 0x33, 0xa8, 0x46,
+	0x39, 0x3e, 0xb5, 0x10, 0x6c, 0x83, 0x69, 0x4e, 0x7b, 0x98, 0x98, 0x0e, 0x49, 0x3a, 0xf8, 0x6f,
+	0x7d, 0x5e, 0x4b, 0x75, 0xa9, 0xc5, 0xba, 0xd4, 0x32, 0x5d, 0x6a, 0xcf, 0xa9, 0x43, 0x9a, 0xd5,
+	0xa3, 0x48, 0x29, 0x0d, 0x22, 0xe5, 0x76, 0x9a, 0x4c, 0x04, 0xaa, 0x46, 0x25, 0x39, 0xb6, 0x08,
+	0x7c, 0x07, 0xe6, 0xd8, 0x9e, 0x15, 0x60, 0x93, 0x86, 0xdc, 0xf4, 0x1c, 0x62, 0x5a, 0x1e, 0x0d,
+	0x09, 0xaf, 0x4d, 0x25, 0xa0, 0xdb, 0x71, 0xfc, 0xb7, 0x48, 0x79, 0x64, 0x3b, 0x7c, 0x2f, 0xec,
+	0x68, 0x5d, 0xea, 0xe9, 0xd9, 0x10, 0xa4, 0x3f, 0x6b, 0x0c, 0xf5, 0x74, 0x7e, 0xe8, 0x63, 0xa6,
+	0xb5, 0x08, 0x1f, 0x44, 0xca, 0x42, 0xd6, 0x62, 0x41, 0x4e, 0xd5, 0x98, 0x4d, 0x9e, 0x5f, 0x86,
+	0xbc, 0xed, 0x90, 0xcd, 0xe4, 0x0d, 0xee, 0x81, 0x69, 0x31, 0x65, 0xb5, 0x7f, 0xb2, 0x76, 0xd2,
+	0x31, 0xd4, 0xc4, 0x18, 0x6a, 0x5b, 0x99, 0x43, 0xb3, 0x11, 0xc3, 0xf9, 0x15, 0x29, 0x50, 0x84,
+	0xac, 0x52, 0xcf, 0xe1, 0xd8, 0xf3, 0xf9, 0x61, 0xde, 0xa4, 0xb0, 0xa9, 0x9f, 0xbe, 0x2b, 0x92,
+	0x31, 0xcc, 0x0e, 0xb5, 0x33, 0xb3, 0x51, 0x4e, 0xba, 0xbb, 0x9b, 0x07, 0x09, 0x8b, 0x9a, 0x0f,
+	0xcc, 0x17, 0x09, 0xac, 0x5e, 0xe7, 0x4b, 0x0b, 0x69, 0x9c, 0x15, 0x9e, 0x74, 0x4e, 0x78, 0x0c,
+	0xdc, 0xc9, 0xf9, 0xc8, 0xf8, 0x9d, 0x48, 0x10, 0xb4, 0xc6, 0xe6, 0xb7, 0x3a, 0xca, 0xaf, 0xe0,
+	0x76, 0x46, 0x70, 0x9b, 0x12, 0xbb, 0xfe, 0x61, 0x0a, 0x4c, 0xb6, 0x99, 0x0d, 0x03, 0x00, 0x8b,
+	0xd4, 0xa9, 0x5d, 0xdc, 0x8a, 0x5a, 0xe1, 0x7e, 0xa8, 0x37, 0xae, 0xed, 0x3a, 0x64, 0xe2, 0x00,
+	0xcc, 0x15, 0xee, 0x91, 0x95, 0x2b, 0x53, 0xe5, 0xce, 0xf5, 0x8d, 0x31, 0x9c, 0x8b, 0x2b, 0x1b,
+	0x78, 0x8c, 0xca, 0x06, 0x1e, 0xa3, 0xf2, 0xc5, 0xc5, 0x00, 0x3f, 0x4b, 0x60, 0xe9, 0xea, 0xad,
+	0xf0, 0xe4, 0x92, 0xd4, 0x57, 0x46, 0xd6, 0x9f, 0xfd, 0x69, 0xa4, 0x40, 0xd8, 0xdc, 0x3e, 0x3a,
+	0x91, 0xa5, 0xe3, 0x13, 0x59, 0xfa, 0x71, 0x22, 0x4b, 0x1f, 0x4f, 0xe5, 0xd2, 0xf1, 0xa9, 0x5c,
+	0xfa, 0x7a, 0x2a, 0x97, 0xde, 0xac, 0x9f, 0x91, 0x5f, 0x56, 0x65, 0xcd, 0xb5, 0x3a, 0x4c, 0x5c,
+	0xf4, 0x83, 0x73, 0xff, 0xac, 0xb1, 0x1c, 0x3b, 0xe5, 0x64, 0x3c, 0x37, 0x7e, 0x0f, 0x00, 0x2c,
+	0x15, 0xae, 0x07, 0x7c, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -369,6 +522,9 @@ type MsgClient interface {
 	SuperfluidUndelegate(ctx context.Context, in *MsgSuperfluidUndelegate, opts ...grpc.CallOption) (*MsgSuperfluidUndelegateResponse, error)
 	// Execute superfluid redelegation for a lockup
 	SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidRedelegate, opts ...grpc.CallOption) (*MsgSuperfluidRedelegateResponse, error)
+	// Join a pool with a single token, lock the shares, and optionally
+	// superfluid delegate the new lockup
+	JoinSwapLockAndSuperfluidDelegate(ctx context.Context, in *MsgJoinSwapLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgJoinSwapLockAndSuperfluidDelegateResponse, error)
 }
 
 type msgClient struct {
@@ -406,6 +562,15 @@ func (c *msgClient) SuperfluidRedelegate(ctx context.Context, in *MsgSuperfluidR
 	return out, nil
 }
 
+func (c *msgClient) JoinSwapLockAndSuperfluidDelegate(ctx context.Context, in *MsgJoinSwapLockAndSuperfluidDelegate, opts ...grpc.CallOption) (*MsgJoinSwapLockAndSuperfluidDelegateResponse, error) {
+	out := new(MsgJoinSwapLockAndSuperfluidDelegateResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.superfluid.Msg/JoinSwapLockAndSuperfluidDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Execute superfluid delegation for a lockup
@@ -414,6 +579,9 @@ type MsgServer interface {
 	SuperfluidUndelegate(context.Context, *MsgSuperfluidUndelegate) (*MsgSuperfluidUndelegateResponse, error)
 	// Execute superfluid redelegation for a lockup
 	SuperfluidRedelegate(context.Context, *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error)
+	// Join a pool with a single token, lock the shares, and optionally
+	// superfluid delegate the new lockup
+	JoinSwapLockAndSuperfluidDelegate(context.Context, *MsgJoinSwapLockAndSuperfluidDelegate) (*MsgJoinSwapLockAndSuperfluidDelegateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -429,6 +597,9 @@ func (*UnimplementedMsgServer) SuperfluidUndelegate(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) SuperfluidRedelegate(ctx context.Context, req *MsgSuperfluidRedelegate) (*MsgSuperfluidRedelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SuperfluidRedelegate not implemented")
 }
+func (*UnimplementedMsgServer) JoinSwapLockAndSuperfluidDelegate(ctx context.Context, req *MsgJoinSwapLockAndSuperfluidDelegate) (*MsgJoinSwapLockAndSuperfluidDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinSwapLockAndSuperfluidDelegate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -488,6 +659,24 @@ func _Msg_SuperfluidRedelegate_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_JoinSwapLockAndSuperfluidDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgJoinSwapLockAndSuperfluidDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).JoinSwapLockAndSuperfluidDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.superfluid.Msg/JoinSwapLockAndSuperfluidDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).JoinSwapLockAndSuperfluidDelegate(ctx, req.(*MsgJoinSwapLockAndSuperfluidDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.superfluid.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -504,6 +693,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SuperfluidRedelegate",
 			Handler:    _Msg_SuperfluidRedelegate_Handler,
 		},
+		{
+			MethodName: "JoinSwapLockAndSuperfluidDelegate",
+			Handler:    _Msg_JoinSwapLockAndSuperfluidDelegate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/superfluid/tx.proto",
@@ -697,6 +890,114 @@ func (m *MsgSuperfluidRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValAddr) > 0 {
+		i -= len(m.ValAddr)
+		copy(dAtA[i:], m.ValAddr)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValAddr)))
+		i--
+		dAtA[i] = 0x32
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -791,6 +1092,46 @@ func (m *MsgSuperfluidRedelegateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.ValAddr)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1314,6 +1655,342 @@ func (m *MsgSuperfluidRedelegateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgJoinSwapLockAndSuperfluidDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinSwapLockAndSuperfluidDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinSwapLockAndSuperfluidDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgJoinSwapLockAndSuperfluidDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgJoinSwapLockAndSuperfluidDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgJoinSwapLockAndSuperfluidDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,6 +27,9 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetCmdFeeTokens(),
 		GetCmdDenomPoolID(),
 		GetCmdBaseDenom(),
+		GetCmdBaseFee(),
+		GetCmdParams(),
+		GetCmdEstimateFee(),
 	)
 
 	return cmd
@@ -140,3 +144,120 @@ $ %s query txfees base-denom
 
 	return cmd
 }
+
+// GetCmdBaseFee returns the current base fee
+func GetCmdBaseFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Short: "Query the current base fee, in base denom per gas",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current base fee, in base denom per gas, and whether it is enabled.
+
+Example:
+$ %s query txfees base-fee
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the txfees module params
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the txfees module params",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the txfees module params.
+
+Example:
+$ %s query txfees params
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdEstimateFee returns the minimum fee in a fee denom for a tx with the given gas
+func GetCmdEstimateFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-fee [gas] [fee-denom]",
+		Short: "Query the minimum fee in a fee denom for a tx with the given gas to enter the node's mempool",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the minimum fee in the base denom or a whitelisted fee token,
+for a tx with the given gas to enter the mempool of the queried node.
+
+Example:
+$ %s query txfees estimate-fee 200000 uion
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			gas, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EstimateFee(cmd.Context(), &types.QueryEstimateFeeRequest{
+				Gas:      gas,
+				FeeDenom: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -9,6 +9,9 @@ import (
 // InitGenesis initializes the txfees module's state from a provided genesis
 // state.
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// params are set first, as fee tokens are validated against them
+	k.SetParams(ctx, genState.Params)
+	k.SetBaseFee(ctx, genState.BaseFee)
 	err := k.SetBaseDenom(ctx, genState.Basedenom)
 	if err != nil {
 		panic(err)
@@ -24,5 +27,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Basedenom, _ = k.GetBaseDenom(ctx)
 	genesis.Feetokens = k.GetFeeTokens(ctx)
+	genesis.Params = k.GetParams(ctx)
+	genesis.BaseFee = k.GetBaseFee(ctx)
 	return genesis
 }
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+// GetBaseFee returns the current base fee, in base denom per gas.
+// Returns the min base fee if it was never set.
+func (k Keeper) GetBaseFee(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseFeeKey)
+	if bz == nil {
+		return k.GetParams(ctx).MinBaseFee
+	}
+
+	baseFee := sdk.Dec{}
+	err := baseFee.Unmarshal(bz)
+	if err != nil {
+		panic(err)
+	}
+	return baseFee
+}
+
+// SetBaseFee sets the current base fee, in base denom per gas.
+func (k Keeper) SetBaseFee(ctx sdk.Context, baseFee sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.BaseFeeKey, bz)
+}
+
+// UpdateBaseFee sets the base fee of the next block from the gas used by the current block.
+// The base fee rises while blocks use more than the target block gas, and falls while they use less,
+// by up to the base fee change rate per block, within the min and max base fee.
+// It is left untouched while the base fee is disabled.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context, blockGasUsed uint64) {
+	params := k.GetParams(ctx)
+	if !params.BaseFeeEnabled {
+		return
+	}
+
+	baseFee := k.GetBaseFee(ctx)
+	k.SetBaseFee(ctx, calcNextBaseFee(params, baseFee, blockGasUsed))
+}
+
+// calcNextBaseFee returns baseFee * (1 + changeRate * (gasUsed - target) / target),
+// with the gas used capped at twice the target, so that the base fee changes by at most the change rate.
+func calcNextBaseFee(params types.Params, baseFee sdk.Dec, blockGasUsed uint64) sdk.Dec {
+	target := sdk.NewIntFromUint64(params.TargetBlockGas)
+	gasUsed := sdk.MinInt(sdk.NewIntFromUint64(blockGasUsed), target.MulRaw(2))
+
+	change := baseFee.Mul(params.BaseFeeChangeRate).MulInt(gasUsed.Sub(target)).QuoInt(target)
+	return params.ClampBaseFee(baseFee.Add(change))
+}
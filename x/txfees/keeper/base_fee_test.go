@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+
+	"github.com/osmosis-labs/osmosis/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestUpdateBaseFee() {
+	target := uint64(1000000)
+
+	tests := []struct {
+		name            string
+		enabled         bool
+		baseFee         sdk.Dec
+		blockGasUsed    uint64
+		expectedBaseFee sdk.Dec
+	}{
+		{
+			name:            "disabled base fee is left untouched",
+			enabled:         false,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    2 * target,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:            "block at the target",
+			enabled:         true,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    target,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		{
+			name:            "block at twice the target",
+			enabled:         true,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    2 * target,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01125"),
+		},
+		{
+			name:            "block above twice the target changes by at most the change rate",
+			enabled:         true,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    10 * target,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.01125"),
+		},
+		{
+			name:            "block at half the target",
+			enabled:         true,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    target / 2,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.009375"),
+		},
+		{
+			name:            "empty block",
+			enabled:         true,
+			baseFee:         sdk.MustNewDecFromStr("0.01"),
+			blockGasUsed:    0,
+			expectedBaseFee: sdk.MustNewDecFromStr("0.00875"),
+		},
+		{
+			name:            "base fee does not fall below the min base fee",
+			enabled:         true,
+			baseFee:         types.DefaultMinBaseFee,
+			blockGasUsed:    0,
+			expectedBaseFee: types.DefaultMinBaseFee,
+		},
+		{
+			name:            "base fee does not rise above the max base fee",
+			enabled:         true,
+			baseFee:         types.DefaultMaxBaseFee,
+			blockGasUsed:    2 * target,
+			expectedBaseFee: types.DefaultMaxBaseFee,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest(false)
+
+		params := types.DefaultParams()
+		params.BaseFeeEnabled = tc.enabled
+		params.TargetBlockGas = target
+		suite.app.TxFeesKeeper.SetParams(suite.ctx, params)
+		suite.app.TxFeesKeeper.SetBaseFee(suite.ctx, tc.baseFee)
+
+		suite.app.TxFeesKeeper.UpdateBaseFee(suite.ctx, tc.blockGasUsed)
+		suite.Require().Equal(tc.expectedBaseFee, suite.app.TxFeesKeeper.GetBaseFee(suite.ctx), "test: %s", tc.name)
+
+		res, err := suite.queryClient.BaseFee(sdk.WrapSDKContext(suite.ctx), &types.QueryBaseFeeRequest{})
+		suite.Require().NoError(err)
+		suite.Require().Equal(tc.expectedBaseFee, res.BaseFee, "test: %s", tc.name)
+		suite.Require().Equal(tc.enabled, res.Enabled, "test: %s", tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeInMempoolFeeCheck() {
+	suite.SetupTest(false)
+	suite.ctx = suite.ctx.WithIsCheckTx(true)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	gas := uint64(10000)
+
+	suite.app.TxFeesKeeper.SetBaseFee(suite.ctx, sdk.MustNewDecFromStr("0.1"))
+
+	tests := []struct {
+		name         string
+		enabled      bool
+		txFee        sdk.Coins
+		minGasPrices sdk.DecCoins
+		expectPass   bool
+	}{
+		{
+			name:       "disabled base fee is not required",
+			enabled:    false,
+			txFee:      sdk.NewCoins(),
+			expectPass: true,
+		},
+		{
+			name:       "fee below the base fee",
+			enabled:    true,
+			txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 999)),
+			expectPass: false,
+		},
+		{
+			name:       "fee at the base fee",
+			enabled:    true,
+			txFee:      sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			expectPass: true,
+		},
+		{
+			name:         "validator min gas price above the base fee",
+			enabled:      true,
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewInt64DecCoin(baseDenom, 1)),
+			expectPass:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		params := types.DefaultParams()
+		params.BaseFeeEnabled = tc.enabled
+		suite.app.TxFeesKeeper.SetParams(suite.ctx, params)
+		ctx := suite.ctx.WithMinGasPrices(tc.minGasPrices)
+
+		tx := legacytx.NewStdTx([]sdk.Msg{}, legacytx.NewStdFee(gas, tc.txFee), []legacytx.StdSignature{}, "")
+
+		mfd := keeper.NewMempoolFeeDecorator(*suite.app.TxFeesKeeper, mempoolFeeOpts)
+		antehandler := sdk.ChainAnteDecorators(mfd)
+		_, err := antehandler(ctx, tx, false)
+		if tc.expectPass {
+			suite.Require().NoError(err, "test: %s", tc.name)
+		} else {
+			suite.Require().Error(err, "test: %s", tc.name)
+		}
+	}
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	"github.com/osmosis-labs/osmosis/app"
+	"github.com/osmosis-labs/osmosis/x/txfees/keeper"
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestDeductFees() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	uion := "uion"
+
+	tests := []struct {
+		name                  string
+		fees                  sdk.Coins
+		expectedFeeCollector  sdk.Coins
+		expectedTxFeesAccount sdk.Coins
+		expectPass            bool
+	}{
+		{
+			name:                  "base denom fee goes to the fee collector",
+			fees:                  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			expectedFeeCollector:  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			expectedTxFeesAccount: sdk.NewCoins(),
+			expectPass:            true,
+		},
+		{
+			name:                  "non base denom fee goes to the txfees module account",
+			fees:                  sdk.NewCoins(sdk.NewInt64Coin(uion, 1000)),
+			expectedFeeCollector:  sdk.NewCoins(),
+			expectedTxFeesAccount: sdk.NewCoins(sdk.NewInt64Coin(uion, 1000)),
+			expectPass:            true,
+		},
+		{
+			name:                  "mixed fees are split",
+			fees:                  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 10), sdk.NewInt64Coin(uion, 20)),
+			expectedFeeCollector:  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 10)),
+			expectedTxFeesAccount: sdk.NewCoins(sdk.NewInt64Coin(uion, 20)),
+			expectPass:            true,
+		},
+		{
+			name:       "insufficient funds",
+			fees:       sdk.NewCoins(sdk.NewInt64Coin(uion, 100000000)),
+			expectPass: false,
+		},
+	}
+
+	for _, tc := range tests {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		acc := suite.app.AccountKeeper.NewAccountWithAddress(cacheCtx, acc1)
+
+		err := keeper.DeductFees(*suite.app.TxFeesKeeper, suite.app.BankKeeper, cacheCtx, acc, tc.fees)
+		if !tc.expectPass {
+			suite.Require().Error(err, "test: %s", tc.name)
+			continue
+		}
+		suite.Require().NoError(err, "test: %s", tc.name)
+
+		feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		txFeesAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+		suite.Require().Equal(tc.expectedFeeCollector.String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, feeCollectorAddr).String(), "test: %s", tc.name)
+		suite.Require().Equal(tc.expectedTxFeesAccount.String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, txFeesAddr).String(), "test: %s", tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDeductFeeDecoratorWithFeeGrant() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	uion := "uion"
+
+	uionPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 500),
+		sdk.NewInt64Coin(uion, 500),
+	)
+	suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId)
+
+	// acc1 grants acc2 an allowance of base denom and uion fees
+	granter, grantee := acc1, acc2
+	err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter, grantee, &feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin(uion, 1000)),
+	})
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name                  string
+		fees                  sdk.Coins
+		feeGranter            sdk.AccAddress
+		expectedFeeCollector  sdk.Coins
+		expectedTxFeesAccount sdk.Coins
+		expectPass            bool
+	}{
+		{
+			name:                  "granted base denom fee",
+			fees:                  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			feeGranter:            granter,
+			expectedFeeCollector:  sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100)),
+			expectedTxFeesAccount: sdk.NewCoins(),
+			expectPass:            true,
+		},
+		{
+			name:                  "granted non base denom fee",
+			fees:                  sdk.NewCoins(sdk.NewInt64Coin(uion, 100)),
+			feeGranter:            granter,
+			expectedFeeCollector:  sdk.NewCoins(),
+			expectedTxFeesAccount: sdk.NewCoins(sdk.NewInt64Coin(uion, 100)),
+			expectPass:            true,
+		},
+		{
+			name:       "fee above the allowance",
+			fees:       sdk.NewCoins(sdk.NewInt64Coin(uion, 1001)),
+			feeGranter: granter,
+			expectPass: false,
+		},
+		{
+			name:       "fee denom not in the allowance",
+			fees:       sdk.NewCoins(sdk.NewInt64Coin("foo", 1)),
+			feeGranter: granter,
+			expectPass: false,
+		},
+		{
+			name:       "no allowance from the granter",
+			fees:       sdk.NewCoins(sdk.NewInt64Coin(uion, 100)),
+			feeGranter: acc3,
+			expectPass: false,
+		},
+	}
+
+	txConfig := app.MakeEncodingConfig().TxConfig
+	dfd := keeper.NewDeductFeeDecorator(*suite.app.TxFeesKeeper, suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.FeeGrantKeeper)
+	antehandler := sdk.ChainAnteDecorators(dfd)
+
+	for _, tc := range tests {
+		cacheCtx, _ := suite.ctx.CacheContext()
+
+		txBuilder := txConfig.NewTxBuilder()
+		err := txBuilder.SetMsgs(banktypes.NewMsgSend(grantee, acc3, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1))))
+		suite.Require().NoError(err)
+		txBuilder.SetFeeAmount(tc.fees)
+		txBuilder.SetFeeGranter(tc.feeGranter)
+		txBuilder.SetGasLimit(10000)
+
+		granterBalance := suite.app.BankKeeper.GetAllBalances(cacheCtx, tc.feeGranter)
+		granteeBalance := suite.app.BankKeeper.GetAllBalances(cacheCtx, grantee)
+
+		_, err = antehandler(cacheCtx, txBuilder.GetTx(), false)
+		if !tc.expectPass {
+			suite.Require().Error(err, "test: %s", tc.name)
+			continue
+		}
+		suite.Require().NoError(err, "test: %s", tc.name)
+
+		// the fees are paid by the granter, not the grantee
+		suite.Require().Equal(granterBalance.Sub(tc.fees).String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, tc.feeGranter).String(), "test: %s", tc.name)
+		suite.Require().Equal(granteeBalance.String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, grantee).String(), "test: %s", tc.name)
+
+		feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		txFeesAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+		suite.Require().Equal(tc.expectedFeeCollector.String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, feeCollectorAddr).String(), "test: %s", tc.name)
+		suite.Require().Equal(tc.expectedTxFeesAccount.String(), suite.app.BankKeeper.GetAllBalances(cacheCtx, txFeesAddr).String(), "test: %s", tc.name)
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -38,17 +40,19 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// Ensure that the provided gas is less than the maximum gas per tx,
 	// if this is a CheckTx. This is only for local mempool purposes, and thus
 	// is only ran on check tx.
+	// The maximum is lowered by the msg gas policies of the msgs of the tx.
 	if ctx.IsCheckTx() && !simulate {
-		if feeTx.GetGas() > mfd.Opts.MaxGasWantedPerTx {
+		maxGasWanted := mfd.Opts.MaxGasWantedForMsgs(feeTx.GetMsgs())
+		if feeTx.GetGas() > maxGasWanted {
 			msg := "Too much gas wanted: %d, maximum is %d"
-			return ctx, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, msg, feeTx.GetGas(), mfd.Opts.MaxGasWantedPerTx)
+			return ctx, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, msg, feeTx.GetGas(), maxGasWanted)
 		}
 	}
 
 	feeCoins := feeTx.GetFee()
 
-	if len(feeCoins) > 1 {
-		return ctx, types.ErrTooManyFeeCoins
+	if len(feeCoins) > types.MaxFeeCoins {
+		return ctx, sdkerrors.Wrapf(types.ErrTooManyFeeCoins, "got %d, maximum is %d", len(feeCoins), types.MaxFeeCoins)
 	}
 
 	baseDenom, err := mfd.TxFeesKeeper.GetBaseDenom(ctx)
@@ -56,11 +60,11 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 		return ctx, err
 	}
 
-	// If there is a fee attached to the tx, make sure the fee denom is a denom accepted by the chain
-	if len(feeCoins) == 1 {
-		feeDenom := feeCoins.GetDenomByIndex(0)
-		if feeDenom != baseDenom {
-			_, err := mfd.TxFeesKeeper.GetFeeToken(ctx, feeDenom)
+	// Make sure every fee denom is a denom accepted by the chain.
+	// The fee coins are sorted, so the first unaccepted denom is always the one rejected.
+	for _, feeCoin := range feeCoins {
+		if feeCoin.Denom != baseDenom {
+			_, err := mfd.TxFeesKeeper.GetFeeToken(ctx, feeCoin.Denom)
 			if err != nil {
 				return ctx, err
 			}
@@ -72,12 +76,16 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	// So we ensure that the provided fees meet a minimum threshold for the validator,
 	// converting every non-osmo specified asset into an osmo-equivalent amount, to determine sufficiency.
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
+		// The fee conversion is local to this node's mempool and skipped when simulating,
+		// so its reads are not charged to the tx, which would make simulated gas estimates too low.
+		feeCheckCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
 		minBaseGasPrice := mfd.GetMinBaseGasPriceForTx(ctx, baseDenom, feeTx)
 		if !(minBaseGasPrice.IsZero()) {
-			if len(feeCoins) != 1 {
+			if feeCoins.IsZero() {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "no fee attached")
 			}
-			err = mfd.TxFeesKeeper.IsSufficientFee(ctx, minBaseGasPrice, feeTx.GetGas(), feeCoins[0])
+			err = mfd.TxFeesKeeper.IsSufficientFee(feeCheckCtx, minBaseGasPrice, feeTx.GetGas(), feeCoins, mfd.Opts.FeeTwapWindow)
 			if err != nil {
 				return ctx, err
 			}
@@ -87,35 +95,102 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
-func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasRequested uint64, feeCoin sdk.Coin) error {
+// IsSufficientFee checks if the fees are at least minBaseGasPrice * gasRequested, converting each fee coin
+// into the base denom at the time weighted price over twapWindow, see ConvertToBaseTokenWithTWAP,
+// and adding them up.
+func (k Keeper) IsSufficientFee(ctx sdk.Context, minBaseGasPrice sdk.Dec, gasRequested uint64, feeCoins sdk.Coins, twapWindow time.Duration) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
 		return err
 	}
 
-	// Determine the required fees by multiplying the required minimum gas
-	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
-	glDec := sdk.NewDec(int64(gasRequested))
-	requiredBaseFee := sdk.NewCoin(baseDenom, minBaseGasPrice.Mul(glDec).Ceil().RoundInt())
+	requiredBaseFee := calcRequiredBaseFee(baseDenom, minBaseGasPrice, gasRequested)
 
-	convertedFee, err := k.ConvertToBaseToken(ctx, feeCoin)
-	if err != nil {
-		return err
+	convertedFee := sdk.NewCoin(baseDenom, sdk.ZeroInt())
+	for _, feeCoin := range feeCoins {
+		converted, err := k.ConvertToBaseTokenWithTWAP(ctx, feeCoin, twapWindow)
+		if err != nil {
+			return err
+		}
+		convertedFee = convertedFee.Add(converted)
 	}
 	if !(convertedFee.IsGTE(requiredBaseFee)) {
-		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s which converts to %s. required: %s", feeCoin, convertedFee, requiredBaseFee)
+		return sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s which converts to %s. required: %s", feeCoins, convertedFee, requiredBaseFee)
 	}
 
 	return nil
 }
 
+// calcRequiredBaseFee determines the required fees by multiplying the required minimum gas
+// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
+func calcRequiredBaseFee(baseDenom string, minBaseGasPrice sdk.Dec, gasRequested uint64) sdk.Coin {
+	glDec := sdk.NewDec(int64(gasRequested))
+	return sdk.NewCoin(baseDenom, minBaseGasPrice.Mul(glDec).Ceil().RoundInt())
+}
+
+// GetMinBaseGasPriceForTx returns the minimum gas price in the base denom for the tx to enter the mempool.
+// This is the validator's own min gas price, raised by the mempool fee options for high gas and arbitrage txs,
+// and for the msg types of the tx, and by the chain's base fee while it is enabled.
 func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, baseDenom string, tx sdk.FeeTx) sdk.Dec {
-	cfgMinGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
-	if tx.GetGas() >= mfd.Opts.HighGasTxThreshold {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForHighGasTx)
+	return mfd.TxFeesKeeper.getMinBaseGasPrice(ctx, baseDenom, tx.GetGas(), tx.GetMsgs(), txfee_filters.IsArbTxLoose(tx), mfd.Opts)
+}
+
+func (k Keeper) getMinBaseGasPrice(ctx sdk.Context, baseDenom string, gas uint64, msgs []sdk.Msg, isArbTx bool, opts types.MempoolFeeOptions) sdk.Dec {
+	cfgMinGasPrice := sdk.MaxDec(ctx.MinGasPrices().AmountOf(baseDenom), opts.MinGasPriceForMsgs(msgs))
+	if k.GetParams(ctx).BaseFeeEnabled {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, k.GetBaseFee(ctx))
+	}
+	if gas >= opts.HighGasTxThreshold {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, opts.MinGasPriceForHighGasTx)
 	}
-	if txfee_filters.IsArbTxLoose(tx) {
-		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, mfd.Opts.MinGasPriceForArbitrageTx)
+	if isArbTx {
+		cfgMinGasPrice = sdk.MaxDec(cfgMinGasPrice, opts.MinGasPriceForArbitrageTx)
 	}
 	return cfgMinGasPrice
 }
+
+// EstimateMempoolFee returns the minimum fee in feeDenom for a tx with the given gas and msgs to enter the mempool of
+// this node, as checked by the MempoolFeeDecorator with this node's min gas prices and mempool fee options.
+// The msgs may be left empty, in which case the tx is not classified as an arbitrage tx.
+func (k Keeper) EstimateMempoolFee(ctx sdk.Context, gas uint64, feeDenom string, msgs []sdk.Msg) (*types.QueryEstimateFeeResponse, error) {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	isHighGasTx := gas >= k.mempoolFeeOpts.HighGasTxThreshold
+	isArbTx := txfee_filters.IsArbMsgsLoose(msgs)
+	minBaseGasPrice := k.getMinBaseGasPrice(ctx, baseDenom, gas, msgs, isArbTx, k.mempoolFeeOpts)
+	requiredBaseFee := calcRequiredBaseFee(baseDenom, minBaseGasPrice, gas)
+
+	fee := requiredBaseFee
+	if feeDenom != baseDenom {
+		feeToken, err := k.GetFeeToken(ctx, feeDenom)
+		if err != nil {
+			return nil, err
+		}
+		price, err := k.getFeeTokenPrice(ctx, feeToken, baseDenom, k.mempoolFeeOpts.FeeTwapWindow)
+		if err != nil {
+			return nil, err
+		}
+		if !price.IsPositive() {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidFeeToken, "%s has no price in %s", feeDenom, baseDenom)
+		}
+
+		// the smallest amount which converts to at least the required base fee,
+		// where the conversion is ceil(price * amount)
+		amount := requiredBaseFee.Amount.ToDec().Quo(price).Ceil().TruncateInt()
+		if price.MulInt(amount).Ceil().TruncateInt().LT(requiredBaseFee.Amount) {
+			amount = amount.AddRaw(1)
+		}
+		fee = sdk.NewCoin(feeDenom, amount)
+	}
+
+	return &types.QueryEstimateFeeResponse{
+		Fee:             fee,
+		BaseFee:         requiredBaseFee,
+		MinBaseGasPrice: minBaseGasPrice,
+		IsHighGasTx:     isHighGasTx,
+		IsArbitrageTx:   isArbTx,
+	}, nil
+}
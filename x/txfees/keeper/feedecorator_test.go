@@ -5,6 +5,8 @@ import (
 	"github.com/osmosis-labs/osmosis/x/txfees/types"
 
 	"github.com/cosmos/cosmos-sdk/x/auth/legacy/legacytx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	"github.com/osmosis-labs/osmosis/x/txfees/keeper"
 )
@@ -108,7 +110,7 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 			minGasPrices: sdk.NewDecCoins(),
 			gasRequested: 10000,
 			isCheckTx:    true,
-			expectPass:   false,
+			expectPass:   true,
 		},
 		{
 			name:         "multiple fee coins - delivertx",
@@ -116,6 +118,42 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 			minGasPrices: sdk.NewDecCoins(),
 			gasRequested: 10000,
 			isCheckTx:    false,
+			expectPass:   true,
+		},
+		{
+			name:  "multiple fee coins with enough combined value",
+			txFee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500), sdk.NewInt64Coin(uion, 500)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
+				sdk.MustNewDecFromStr("0.1"))),
+			gasRequested: 10000,
+			isCheckTx:    true,
+			expectPass:   true,
+		},
+		{
+			name:  "multiple fee coins without enough combined value",
+			txFee: sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 500), sdk.NewInt64Coin(uion, 499)),
+			minGasPrices: sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom,
+				sdk.MustNewDecFromStr("0.1"))),
+			gasRequested: 10000,
+			isCheckTx:    true,
+			expectPass:   false,
+		},
+		{
+			name:         "multiple fee coins with an invalid fee denom - delivertx",
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000), sdk.NewInt64Coin("moo", 1)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
+			expectPass:   false,
+		},
+		{
+			name: "too many fee coins",
+			txFee: sdk.NewCoins(sdk.NewInt64Coin("aaa", 1), sdk.NewInt64Coin("bbb", 1), sdk.NewInt64Coin("ccc", 1),
+				sdk.NewInt64Coin("ddd", 1), sdk.NewInt64Coin("eee", 1), sdk.NewInt64Coin("fff", 1),
+				sdk.NewInt64Coin("ggg", 1), sdk.NewInt64Coin("hhh", 1), sdk.NewInt64Coin("iii", 1)),
+			minGasPrices: sdk.NewDecCoins(),
+			gasRequested: 10000,
+			isCheckTx:    false,
 			expectPass:   false,
 		},
 		{
@@ -180,3 +218,138 @@ func (suite *KeeperTestSuite) TestFeeDecorator() {
 		}
 	}
 }
+
+func (suite *KeeperTestSuite) TestFeeDecoratorMsgGasPolicy() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	sendMsg := banktypes.NewMsgSend(acc1, acc2, sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1)))
+
+	mempoolFeeOpts := types.NewDefaultMempoolFeeOptions()
+	mempoolFeeOpts.MsgGasPolicies[sdk.MsgTypeURL(sendMsg)] = types.MsgGasPolicy{
+		MinGasPrice:  sdk.MustNewDecFromStr("0.1"),
+		MaxGasWanted: 100000,
+	}
+
+	execMsg := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(acc1, msgs)
+		return &msg
+	}
+	mempoolFeeOpts.MsgGasPolicies[sdk.MsgTypeURL(execMsg())] = types.MsgGasPolicy{
+		MinGasPrice:  sdk.ZeroDec(),
+		MaxGasWanted: 50000,
+	}
+
+	// a msg without policy
+	multiSendMsg := banktypes.NewMsgMultiSend(nil, nil)
+	// a msg without policy, nested deeper than the msgs of authz exec msgs are looked into
+	nestedExecMsg := execMsg(multiSendMsg)
+	for i := 0; i < 7; i++ {
+		nestedExecMsg = execMsg(nestedExecMsg)
+	}
+
+	tests := []struct {
+		name         string
+		msgs         []sdk.Msg
+		txFee        sdk.Coins
+		gasRequested uint64
+		expectPass   bool
+	}{
+		{
+			name:         "msg without policy needs no fee",
+			msgs:         []sdk.Msg{multiSendMsg},
+			txFee:        sdk.NewCoins(),
+			gasRequested: 10000,
+			expectPass:   true,
+		},
+		{
+			name:         "msg with policy and not enough fee should not pass",
+			msgs:         []sdk.Msg{sendMsg},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 999)),
+			gasRequested: 10000,
+			expectPass:   false,
+		},
+		{
+			name:         "msg with policy and enough fee should pass",
+			msgs:         []sdk.Msg{sendMsg},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			gasRequested: 10000,
+			expectPass:   true,
+		},
+		{
+			name:         "msg with policy and more gas wanted than allowed should not pass",
+			msgs:         []sdk.Msg{sendMsg},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100000)),
+			gasRequested: 100001,
+			expectPass:   false,
+		},
+		{
+			name:         "msg with policy in authz exec and not enough fee should not pass",
+			msgs:         []sdk.Msg{execMsg(sendMsg)},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 999)),
+			gasRequested: 10000,
+			expectPass:   false,
+		},
+		{
+			name:         "msg with policy in nested authz exec and enough fee should pass",
+			msgs:         []sdk.Msg{execMsg(execMsg(sendMsg))},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000)),
+			gasRequested: 10000,
+			expectPass:   true,
+		},
+		{
+			name:         "msg with policy in authz exec and more gas wanted than allowed should not pass",
+			msgs:         []sdk.Msg{execMsg(sendMsg)},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 100000)),
+			gasRequested: 100001,
+			expectPass:   false,
+		},
+		{
+			name:         "authz exec with policy and gas wanted within its policy should pass",
+			msgs:         []sdk.Msg{execMsg(multiSendMsg)},
+			txFee:        sdk.NewCoins(),
+			gasRequested: 50000,
+			expectPass:   true,
+		},
+		{
+			name:         "authz exec with policy and more gas wanted than allowed should not pass",
+			msgs:         []sdk.Msg{execMsg(multiSendMsg)},
+			txFee:        sdk.NewCoins(),
+			gasRequested: 50001,
+			expectPass:   false,
+		},
+		{
+			name:         "nested authz exec with policy and more gas wanted than allowed should not pass",
+			msgs:         []sdk.Msg{execMsg(execMsg(multiSendMsg))},
+			txFee:        sdk.NewCoins(),
+			gasRequested: 50001,
+			expectPass:   false,
+		},
+		{
+			name:         "authz exec nested too deep gets the strictest policy",
+			msgs:         []sdk.Msg{nestedExecMsg},
+			txFee:        sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 999)),
+			gasRequested: 10000,
+			expectPass:   false,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.ctx = suite.ctx.WithIsCheckTx(true)
+		suite.ctx = suite.ctx.WithMinGasPrices(sdk.NewDecCoins())
+
+		tx := legacytx.NewStdTx(tc.msgs, legacytx.NewStdFee(
+			tc.gasRequested,
+			tc.txFee,
+		), []legacytx.StdSignature{}, "")
+
+		mfd := keeper.NewMempoolFeeDecorator(*suite.app.TxFeesKeeper, mempoolFeeOpts)
+		antehandler := sdk.ChainAnteDecorators(mfd)
+		_, err := antehandler(suite.ctx, tx, false)
+		if tc.expectPass {
+			suite.Require().NoError(err, "test: %s", tc.name)
+		} else {
+			suite.Require().Error(err, "test: %s", tc.name)
+		}
+	}
+}
@@ -1,14 +1,50 @@
 package keeper
 
 import (
+	"errors"
+	"strconv"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/x/txfees/types"
 )
 
 // ConvertToBaseToken converts a fee amount in a whitelisted fee token to the base fee token amount
 func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin, error) {
+	return k.convertToBaseToken(ctx, inputFee, func(feeToken types.FeeToken, baseDenom string) (sdk.Dec, error) {
+		return k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	})
+}
+
+// ConvertToBaseTokenWithTWAP converts a fee amount in a whitelisted fee token to the base fee token amount,
+// at the time weighted price over the window, so that the price can't be moved for a moment to pay less fees.
+// Uses the spot price if the window is zero, or if the pool has no price history yet.
+func (k Keeper) ConvertToBaseTokenWithTWAP(ctx sdk.Context, inputFee sdk.Coin, window time.Duration) (sdk.Coin, error) {
+	if window == 0 {
+		return k.ConvertToBaseToken(ctx, inputFee)
+	}
+	return k.convertToBaseToken(ctx, inputFee, func(feeToken types.FeeToken, baseDenom string) (sdk.Dec, error) {
+		return k.getFeeTokenPrice(ctx, feeToken, baseDenom, window)
+	})
+}
+
+// getFeeTokenPrice returns the price of the fee token in the base denom, as used by ConvertToBaseTokenWithTWAP.
+func (k Keeper) getFeeTokenPrice(ctx sdk.Context, feeToken types.FeeToken, baseDenom string, window time.Duration) (sdk.Dec, error) {
+	if window == 0 {
+		return k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	}
+	price, err := k.spotPriceCalculator.CalculateTimeWeightedSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom, window)
+	if errors.Is(err, gammtypes.ErrNoPriceHistory) {
+		return k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	}
+	return price, err
+}
+
+func (k Keeper) convertToBaseToken(ctx sdk.Context, inputFee sdk.Coin, priceFn func(feeToken types.FeeToken, baseDenom string) (sdk.Dec, error)) (sdk.Coin, error) {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
 		return sdk.Coin{}, err
@@ -23,12 +59,12 @@ func (k Keeper) ConvertToBaseToken(ctx sdk.Context, inputFee sdk.Coin) (sdk.Coin
 		return sdk.Coin{}, err
 	}
 
-	spotPrice, err := k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	price, err := priceFn(feeToken, baseDenom)
 	if err != nil {
 		return sdk.Coin{}, err
 	}
 
-	return sdk.NewCoin(baseDenom, spotPrice.MulInt(inputFee.Amount).Ceil().RoundInt()), nil
+	return sdk.NewCoin(baseDenom, price.MulInt(inputFee.Amount).Ceil().RoundInt()), nil
 }
 
 // GetFeeToken returns the fee token record for a specific denom
@@ -63,6 +99,7 @@ func (k Keeper) SetBaseDenom(ctx sdk.Context, denom string) error {
 // - The denom is not the base denom
 // - The gamm pool exists
 // - The gamm pool includes the base token and fee token
+// - The gamm pool has at least the min fee token pool liquidity of the base token
 func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error {
 	baseDenom, err := k.GetBaseDenom(ctx)
 	if err != nil {
@@ -76,8 +113,69 @@ func (k Keeper) ValidateFeeToken(ctx sdk.Context, feeToken types.FeeToken) error
 	// - feeToken.PoolID exists
 	// - feeToken.PoolID has both feeToken.Denom and baseDenom
 	_, err = k.spotPriceCalculator.CalculateSpotPrice(ctx, feeToken.PoolID, feeToken.Denom, baseDenom)
+	if err != nil {
+		return err
+	}
+
+	liquidity, err := k.getFeeTokenPoolLiquidity(ctx, feeToken, baseDenom)
+	if err != nil {
+		return err
+	}
+	minLiquidity := k.GetParams(ctx).MinFeeTokenPoolLiquidity
+	if liquidity.LT(minLiquidity) {
+		return sdkerrors.Wrapf(types.ErrLowPoolLiquidity, "pool %d has %s%s, minimum is %s%s",
+			feeToken.PoolID, liquidity, baseDenom, minLiquidity, baseDenom)
+	}
+
+	return nil
+}
+
+// getFeeTokenPoolLiquidity returns the amount of the base denom in the pool of the fee token.
+func (k Keeper) getFeeTokenPoolLiquidity(ctx sdk.Context, feeToken types.FeeToken, baseDenom string) (sdk.Int, error) {
+	pool, err := k.gammKeeper.GetPool(ctx, feeToken.PoolID)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	poolAsset, err := pool.GetPoolAsset(baseDenom)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return poolAsset.Token.Amount, nil
+}
+
+// DelistIlliquidFeeTokens removes the fee tokens whose pool has less than the min fee token pool liquidity
+// of the base denom from the whitelist, as their price can no longer be relied on.
+// Fees already collected in a removed fee token are kept in the module account until it is whitelisted again.
+func (k Keeper) DelistIlliquidFeeTokens(ctx sdk.Context) error {
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		return err
+	}
+	minLiquidity := k.GetParams(ctx).MinFeeTokenPoolLiquidity
+
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		liquidity, err := k.getFeeTokenPoolLiquidity(ctx, feeToken, baseDenom)
+		if err != nil {
+			// the pool no longer has the base denom, so it can't be used to convert fees either
+			k.Logger(ctx).Error("failed to get fee token pool liquidity",
+				"denom", feeToken.Denom, "pool_id", feeToken.PoolID, "error", err.Error())
+			liquidity = sdk.ZeroInt()
+		}
+		if liquidity.GTE(minLiquidity) {
+			continue
+		}
 
-	return err
+		k.GetFeeTokensStore(ctx).Delete([]byte(feeToken.Denom))
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.TypeEvtFeeTokenDelisted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyDenom, feeToken.Denom),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(feeToken.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeyPoolLiquidity, sdk.NewCoin(baseDenom, liquidity).String()),
+		))
+	}
+
+	return nil
 }
 
 // GetFeeToken returns the fee token record for a specific denom.
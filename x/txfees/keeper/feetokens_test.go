@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/x/txfees/types"
 )
@@ -218,3 +220,48 @@ func (suite *KeeperTestSuite) TestFeeTokenConversions() {
 	}
 
 }
+
+func (suite *KeeperTestSuite) TestFeeTokenConversionsWithTWAP() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	startTime := suite.ctx.BlockTime()
+
+	poolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin("uion", 1000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal("uion", poolId))
+	inputFee := sdk.NewInt64Coin("uion", 1000)
+
+	// with only the state of the pool creation recorded at the end of its block,
+	// the time weighted price is the spot price
+	suite.app.GAMMKeeper.RecordChangedPoolsTwap(suite.ctx)
+	converted, err := suite.app.TxFeesKeeper.ConvertToBaseTokenWithTWAP(suite.ctx, inputFee, 10*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000).String(), converted.String())
+
+	// move the price in a later block, which moves the spot price at once
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(10 * time.Minute))
+	_, _, err = suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewInt64Coin("uion", 1000000), baseDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+
+	spotConverted, err := suite.app.TxFeesKeeper.ConvertToBaseToken(suite.ctx, inputFee)
+	suite.Require().NoError(err)
+	suite.Require().True(spotConverted.Amount.GT(sdk.NewInt(1000)))
+
+	// but not the time weighted price
+	converted, err = suite.app.TxFeesKeeper.ConvertToBaseTokenWithTWAP(suite.ctx, inputFee, 10*time.Minute)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt64Coin(baseDenom, 1000).String(), converted.String())
+
+	// a zero window uses the spot price
+	converted, err = suite.app.TxFeesKeeper.ConvertToBaseTokenWithTWAP(suite.ctx, inputFee, 0)
+	suite.Require().NoError(err)
+	suite.Require().Equal(spotConverted.String(), converted.String())
+
+	// so the fee is insufficient at the time weighted price, even though the spot price would be enough
+	minBaseGasPrice := sdk.NewDecWithPrec(2, 1)
+	suite.Require().NoError(suite.app.TxFeesKeeper.IsSufficientFee(suite.ctx, minBaseGasPrice, 6000, sdk.NewCoins(inputFee), 0))
+	suite.Require().Error(suite.app.TxFeesKeeper.IsSufficientFee(suite.ctx, minBaseGasPrice, 6000, sdk.NewCoins(inputFee), 10*time.Minute))
+}
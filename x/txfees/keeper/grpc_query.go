@@ -46,3 +46,33 @@ func (k Keeper) BaseDenom(ctx context.Context, _ *types.QueryBaseDenomRequest) (
 
 	return &types.QueryBaseDenomResponse{BaseDenom: baseDenom}, nil
 }
+
+func (k Keeper) BaseFee(ctx context.Context, _ *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee: k.GetBaseFee(sdkCtx),
+		Enabled: k.GetParams(sdkCtx).BaseFeeEnabled,
+	}, nil
+}
+
+func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(sdkCtx)}, nil
+}
+
+func (k Keeper) EstimateFee(ctx context.Context, req *types.QueryEstimateFeeRequest) (*types.QueryEstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	msgs, err := req.GetSDKMsgs()
+	if err != nil {
+		return nil, err
+	}
+
+	return k.EstimateMempoolFee(sdkCtx, req.Gas, req.FeeDenom, msgs)
+}
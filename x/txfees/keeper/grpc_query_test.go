@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestEstimateFee() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	uion := "uion"
+	uionPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin(uion, 3000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId))
+
+	opts := types.NewDefaultMempoolFeeOptions()
+	arbSwap, err := codectypes.NewAnyWithValue(&gammtypes.MsgSwapExactAmountIn{
+		Sender:            acc1.String(),
+		Routes:            []gammtypes.SwapAmountInRoute{{PoolId: uionPoolId, TokenOutDenom: uion}},
+		TokenIn:           sdk.NewInt64Coin(uion, 10),
+		TokenOutMinAmount: sdk.OneInt(),
+	})
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name                    string
+		gas                     uint64
+		feeDenom                string
+		msgs                    []*codectypes.Any
+		minGasPrice             sdk.Dec
+		expectedBaseFee         sdk.Int
+		expectedMinBaseGasPrice sdk.Dec
+		expectHighGas           bool
+		expectArb               bool
+		expectPass              bool
+	}{
+		{
+			name:                    "base denom",
+			gas:                     100000,
+			feeDenom:                baseDenom,
+			minGasPrice:             sdk.MustNewDecFromStr("0.01"),
+			expectedBaseFee:         sdk.NewInt(1000),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.01"),
+			expectPass:              true,
+		},
+		{
+			name:                    "fee token",
+			gas:                     100001,
+			feeDenom:                uion,
+			minGasPrice:             sdk.MustNewDecFromStr("0.01"),
+			expectedBaseFee:         sdk.NewInt(1001),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.01"),
+			expectPass:              true,
+		},
+		{
+			name:                    "high gas tx",
+			gas:                     opts.HighGasTxThreshold,
+			feeDenom:                baseDenom,
+			minGasPrice:             sdk.ZeroDec(),
+			expectedBaseFee:         sdk.ZeroInt(),
+			expectedMinBaseGasPrice: sdk.ZeroDec(),
+			expectHighGas:           true,
+			expectPass:              true,
+		},
+		{
+			name:                    "arbitrage tx",
+			gas:                     100000,
+			feeDenom:                uion,
+			msgs:                    []*codectypes.Any{arbSwap},
+			minGasPrice:             sdk.MustNewDecFromStr("0.01"),
+			expectedBaseFee:         sdk.NewInt(1000),
+			expectedMinBaseGasPrice: sdk.MustNewDecFromStr("0.01"),
+			expectArb:               true,
+			expectPass:              true,
+		},
+		{
+			name:        "not a fee token",
+			gas:         100000,
+			feeDenom:    "foo",
+			minGasPrice: sdk.MustNewDecFromStr("0.01"),
+			expectPass:  false,
+		},
+	}
+
+	for _, tc := range tests {
+		ctx := suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec(baseDenom, tc.minGasPrice)))
+
+		// the query is made on the keeper, as the query client ignores the min gas prices of the context
+		req := &types.QueryEstimateFeeRequest{
+			Gas:      tc.gas,
+			FeeDenom: tc.feeDenom,
+			Msgs:     tc.msgs,
+		}
+		suite.Require().NoError(req.UnpackInterfaces(suite.app.InterfaceRegistry()))
+		res, err := suite.app.TxFeesKeeper.EstimateFee(sdk.WrapSDKContext(ctx), req)
+		if !tc.expectPass {
+			suite.Require().Error(err, "test: %s", tc.name)
+			continue
+		}
+		suite.Require().NoError(err, "test: %s", tc.name)
+		suite.Require().Equal(sdk.NewCoin(baseDenom, tc.expectedBaseFee), res.BaseFee, "test: %s", tc.name)
+		suite.Require().Equal(tc.expectedMinBaseGasPrice, res.MinBaseGasPrice, "test: %s", tc.name)
+		suite.Require().Equal(tc.expectHighGas, res.IsHighGasTx, "test: %s", tc.name)
+		suite.Require().Equal(tc.expectArb, res.IsArbitrageTx, "test: %s", tc.name)
+		suite.Require().Equal(tc.feeDenom, res.Fee.Denom, "test: %s", tc.name)
+
+		// the estimated fee is the smallest sufficient fee
+		suite.Require().NoError(
+			suite.app.TxFeesKeeper.IsSufficientFee(ctx, res.MinBaseGasPrice, tc.gas, sdk.NewCoins(res.Fee), opts.FeeTwapWindow),
+			"test: %s", tc.name)
+		if res.Fee.IsPositive() {
+			lowerFee := sdk.NewCoin(res.Fee.Denom, res.Fee.Amount.SubRaw(1))
+			suite.Require().Error(
+				suite.app.TxFeesKeeper.IsSufficientFee(ctx, res.MinBaseGasPrice, tc.gas, sdk.NewCoins(lowerFee), opts.FeeTwapWindow),
+				"test: %s", tc.name)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func (k Keeper) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+}
+
+// AfterEpochEnd swaps all of the non base denom fees collected in the txfees module account
+// into the base denom, through the pool of each fee token, and sends the proceeds to the fee collector.
+// A swap that would get less than the max fee swap slippage below the time weighted price is skipped,
+// leaving those fees for the next epoch.
+// It then removes the fee tokens whose pool liquidity dropped below the minimum from the whitelist,
+// after their fees were swapped, so that no fees are left behind in a token that is no longer swapped.
+// This is only done at the end of the epoch of the epoch identifier param.
+func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier != k.GetParams(ctx).EpochIdentifier {
+		return
+	}
+
+	baseDenom, err := k.GetBaseDenom(ctx)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+		return
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	maxSlippage := k.GetParams(ctx).MaxFeeSwapSlippage
+
+	for _, feeToken := range k.GetFeeTokens(ctx) {
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddr, feeToken.Denom)
+		if balance.Amount.IsZero() {
+			continue
+		}
+
+		// The swap must get at least the value of the fees at the time weighted price, less the max slippage,
+		// so that it can not be sandwiched by moving the spot price of the pool around the end of the epoch.
+		price, err := k.getFeeTokenPrice(ctx, feeToken, baseDenom, types.FeeSwapTwapWindow)
+		if err != nil {
+			k.Logger(ctx).Error("failed to get fee token price",
+				"denom", feeToken.Denom, "pool_id", feeToken.PoolID, "error", err.Error())
+			continue
+		}
+		minOut := price.MulInt(balance.Amount).Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+
+		// The swap is done in a cache context, so that one failing swap does not affect the others.
+		cacheCtx, write := ctx.CacheContext()
+		_, _, err = k.gammKeeper.SwapExactAmountIn(cacheCtx, moduleAddr, feeToken.PoolID, balance, baseDenom, minOut)
+		if err != nil {
+			k.Logger(ctx).Error("failed to swap fee token to base denom",
+				"denom", feeToken.Denom, "pool_id", feeToken.PoolID, "error", err.Error())
+			continue
+		}
+		write()
+	}
+
+	err = k.DelistIlliquidFeeTokens(ctx)
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+	}
+
+	baseBalance := k.bankKeeper.GetBalance(ctx, moduleAddr, baseDenom)
+	if baseBalance.Amount.IsZero() {
+		return
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(baseBalance))
+	if err != nil {
+		k.Logger(ctx).Error(err.Error())
+	}
+}
+
+// ___________________________________________________________________________________________________
+
+// Hooks wrapper struct for txfees keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Return the wrapper struct
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// epochs hooks
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.BeforeEpochStart(ctx, epochIdentifier, epochNumber)
+}
+
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	h.k.AfterEpochEnd(ctx, epochIdentifier, epochNumber)
+}
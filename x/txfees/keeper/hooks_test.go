@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/x/txfees/types"
+)
+
+func (suite *KeeperTestSuite) TestSwapNonBaseFeesAfterEpochEnd() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	uion, foo := "uion", "foo"
+
+	uionPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin(uion, 1000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId))
+	fooPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin(foo, 1000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(foo, fooPoolId))
+
+	// fees paid in fee tokens, and a token that is not a fee token, which is left as is
+	uionFee, fooFee, barFee := sdk.NewInt64Coin(uion, 1000), sdk.NewInt64Coin(foo, 2000), sdk.NewInt64Coin("bar", 3000)
+	collectedFees := sdk.NewCoins(uionFee, fooFee, barFee)
+	err := simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, collectedFees)
+	suite.Require().NoError(err)
+
+	// the expected swap outputs, in separate cache contexts to leave the pools untouched
+	expectedBaseFees := sdk.ZeroInt()
+	for _, fee := range []struct {
+		poolId uint64
+		coin   sdk.Coin
+	}{{uionPoolId, uionFee}, {fooPoolId, fooFee}} {
+		cacheCtx, _ := suite.ctx.CacheContext()
+		tokenOut, _, err := suite.app.GAMMKeeper.SwapExactAmountIn(cacheCtx, acc1, fee.poolId, fee.coin, baseDenom, sdk.ZeroInt())
+		suite.Require().NoError(err)
+		expectedBaseFees = expectedBaseFees.Add(tokenOut)
+	}
+
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	txFeesAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollectorBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom)
+
+	// nothing is swapped at the end of other epochs
+	suite.app.TxFeesKeeper.AfterEpochEnd(suite.ctx, "week", 1)
+	suite.Require().Equal(collectedFees.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, txFeesAddr).String())
+
+	suite.app.TxFeesKeeper.AfterEpochEnd(suite.ctx, "day", 1)
+
+	feeCollectorAfter := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom)
+	suite.Require().Equal(expectedBaseFees.String(), feeCollectorAfter.Amount.Sub(feeCollectorBefore.Amount).String())
+	suite.Require().Equal(
+		sdk.NewCoins(barFee).String(),
+		suite.app.BankKeeper.GetAllBalances(suite.ctx, txFeesAddr).String())
+}
+
+func (suite *KeeperTestSuite) TestFeeSwapSlippageAfterEpochEnd() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	startTime := suite.ctx.BlockTime()
+
+	poolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin("uion", 1000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal("uion", poolId))
+	suite.app.GAMMKeeper.RecordChangedPoolsTwap(suite.ctx)
+
+	uionFee := sdk.NewInt64Coin("uion", 1000)
+	err := simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(uionFee))
+	suite.Require().NoError(err)
+
+	// move the spot price of uion far below its time weighted price, as a sandwich would
+	suite.ctx = suite.ctx.WithBlockTime(startTime.Add(10 * time.Minute))
+	_, _, err = suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc1, poolId, sdk.NewInt64Coin("uion", 1000000), baseDenom, sdk.OneInt())
+	suite.Require().NoError(err)
+
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	txFeesAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	feeCollectorBefore := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom)
+
+	// the swap is skipped, and the fees are left for the next epoch
+	suite.app.TxFeesKeeper.AfterEpochEnd(suite.ctx, "day", 1)
+	suite.Require().Equal(uionFee.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, txFeesAddr).String())
+	suite.Require().Equal(feeCollectorBefore.String(), suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom).String())
+
+	// it goes through once the max slippage allows for the price move
+	params := suite.app.TxFeesKeeper.GetParams(suite.ctx)
+	params.MaxFeeSwapSlippage = sdk.NewDecWithPrec(8, 1)
+	suite.app.TxFeesKeeper.SetParams(suite.ctx, params)
+
+	suite.app.TxFeesKeeper.AfterEpochEnd(suite.ctx, "day", 2)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, txFeesAddr).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom).IsGTE(feeCollectorBefore.AddAmount(sdk.OneInt())))
+}
+
+func (suite *KeeperTestSuite) TestDelistIlliquidFeeTokensAfterEpochEnd() {
+	suite.SetupTest(false)
+
+	baseDenom, _ := suite.app.TxFeesKeeper.GetBaseDenom(suite.ctx)
+	uion, foo := "uion", "foo"
+
+	params := suite.app.TxFeesKeeper.GetParams(suite.ctx)
+	params.MinFeeTokenPoolLiquidity = sdk.NewInt(1000000)
+	suite.app.TxFeesKeeper.SetParams(suite.ctx, params)
+
+	// a pool below the minimum liquidity is not accepted as a fee token pool
+	lowPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 999999),
+		sdk.NewInt64Coin(uion, 1000000),
+	)
+	err := suite.ExecuteUpgradeFeeTokenProposal(uion, lowPoolId)
+	suite.Require().ErrorIs(err, types.ErrLowPoolLiquidity)
+
+	uionPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 1000000),
+		sdk.NewInt64Coin(uion, 1000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(uion, uionPoolId))
+	fooPoolId := suite.PreparePoolWithAssets(
+		sdk.NewInt64Coin(baseDenom, 2000000),
+		sdk.NewInt64Coin(foo, 2000000),
+	)
+	suite.Require().NoError(suite.ExecuteUpgradeFeeTokenProposal(foo, fooPoolId))
+
+	// drain the base denom from the uion pool below the minimum
+	_, _, err = suite.app.GAMMKeeper.SwapExactAmountIn(suite.ctx, acc1, uionPoolId, sdk.NewInt64Coin(uion, 10000), baseDenom, sdk.ZeroInt())
+	suite.Require().NoError(err)
+
+	uionFee := sdk.NewInt64Coin(uion, 1000)
+	err = simapp.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.ModuleName, sdk.NewCoins(uionFee))
+	suite.Require().NoError(err)
+
+	feeCollectorAddr := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	feeCollectorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, feeCollectorAddr, baseDenom)
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.app.TxFeesKeeper.AfterEpochEnd(ctx, "day", 1)
+
+	// uion is delisted, after the fees collected in it were swapped to the fee collector
+	_, err = suite.app.TxFeesKeeper.GetFeeToken(ctx, uion)
+	suite.Require().ErrorIs(err, types.ErrInvalidFeeToken)
+	_, err = suite.app.TxFeesKeeper.GetFeeToken(ctx, foo)
+	suite.Require().NoError(err)
+
+	txFeesAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, txFeesAddr).IsZero())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, feeCollectorAddr, baseDenom).IsGTE(feeCollectorBalance.AddAmount(sdk.OneInt())))
+
+	delisted := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtFeeTokenDelisted {
+			continue
+		}
+		delisted++
+		suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeKeyDenom), Value: []byte(uion)})
+	}
+	suite.Require().Equal(1, delisted)
+}
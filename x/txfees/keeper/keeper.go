@@ -8,28 +8,49 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/osmosis-labs/osmosis/x/txfees/types"
 )
 
 type (
 	Keeper struct {
-		cdc      codec.Codec
-		storeKey sdk.StoreKey
+		cdc        codec.Codec
+		storeKey   sdk.StoreKey
+		paramSpace paramtypes.Subspace
 
+		accountKeeper       types.AccountKeeper
+		bankKeeper          types.BankKeeper
+		gammKeeper          types.GammKeeper
 		spotPriceCalculator types.SpotPriceCalculator
+
+		// mempoolFeeOpts are the mempool fee options of this node, used to estimate fees in queries.
+		mempoolFeeOpts types.MempoolFeeOptions
 	}
 )
 
 func NewKeeper(
 	cdc codec.Codec,
 	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	gammKeeper types.GammKeeper,
 	spotPriceCalculator types.SpotPriceCalculator,
+	mempoolFeeOpts types.MempoolFeeOptions,
 ) Keeper {
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 	return Keeper{
 		cdc:                 cdc,
 		storeKey:            storeKey,
+		paramSpace:          paramSpace,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		gammKeeper:          gammKeeper,
 		spotPriceCalculator: spotPriceCalculator,
+		mempoolFeeOpts:      mempoolFeeOpts,
 	}
 }
 
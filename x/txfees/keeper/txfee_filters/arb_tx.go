@@ -2,50 +2,167 @@ package txfee_filters
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
 	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
+// maxNestedMsgDepth is the deepest level of authz exec msgs that are looked into.
+// Txs with msgs nested any deeper are considered arbitrage txs.
+const maxNestedMsgDepth = 6
+
+// ArbDetector reports whether the msgs of a tx are an arbitrage.
+// The msgs are those of the tx, with the msgs of authz exec msgs in place of the exec msgs.
+type ArbDetector func(msgs []sdk.Msg) bool
+
+// arbDetectors are the detectors run by IsArbTxLoose.
+var arbDetectors = []ArbDetector{
+	isSwapCycle,
+	hasMultipleSwapInDenoms,
+	hasRepeatedSwapDenoms,
+	hasJoinAndExitPool,
+}
+
+// RegisterArbDetector adds a detector to the ones run by IsArbTxLoose,
+// e.g. to recognize arbitrages through CosmWasm contracts.
+// This should only be called while initializing the app, as it is not safe for concurrent use.
+func RegisterArbDetector(detector ArbDetector) {
+	arbDetectors = append(arbDetectors, detector)
+}
+
 // We check if a tx is an arbitrage for the mempool right now by seeing:
 // 1) does start token of a msg = final token of msg (definitionally correct)
 // 2) does it have multiple swap messages, with different tx ins. If so, we assume its an arb.
 //    - This has false positives, but is intended to avoid the obvious solution of splitting
 //      an arb into multiple messages.
-// 3) We record all denoms seen across all swaps, and see if any duplicates. (TODO)
+// 3) We record all denoms seen across all swaps, and see if any duplicates.
+//    - The shared swap in denom of (2) may repeat as the swap in denom of every swap.
+//    - Has false positives, e.g. doing the same swap twice, but it catches cycles in the middle of a route.
 // 4) Contains both JoinPool and ExitPool messages in one tx.
 //    - Has some false positives, but they seem relatively contrived.
+// Msgs wrapped in authz exec msgs are checked as if they were msgs of the tx,
+// as are those of any detector registered with RegisterArbDetector.
 // TODO: Move the first component to a future router module
 func IsArbTxLoose(tx sdk.Tx) bool {
-	msgs := tx.GetMsgs()
+	return IsArbMsgsLoose(tx.GetMsgs())
+}
 
-	swapInDenom := ""
-	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+// IsArbMsgsLoose checks if the msgs of a tx are an arbitrage, see IsArbTxLoose.
+func IsArbMsgsLoose(msgs []sdk.Msg) bool {
+	flatMsgs, ok := FlattenMsgs(msgs)
+	if !ok {
+		return true
+	}
 
+	for _, detector := range arbDetectors {
+		if detector(flatMsgs) {
+			return true
+		}
+	}
+	return false
+}
+
+// FlattenMsgs returns the msgs with the msgs of authz exec msgs in place of the exec msgs.
+// Returns false if the exec msgs are nested deeper than maxNestedMsgDepth, or can't be unpacked,
+// in which case callers should treat the msgs as the worst case for what they check.
+func FlattenMsgs(msgs []sdk.Msg) ([]sdk.Msg, bool) {
+	return flattenMsgs(msgs, 0)
+}
+
+func flattenMsgs(msgs []sdk.Msg, depth int) ([]sdk.Msg, bool) {
+	if depth > maxNestedMsgDepth {
+		return nil, false
+	}
+
+	flatMsgs := make([]sdk.Msg, 0, len(msgs))
 	for _, m := range msgs {
-		// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
-		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
-		if isLpMsg {
-			lpTypesSeen[lpMsg.LiquidityChangeType()] = true
-			if len(lpTypesSeen) > 1 {
-				return true
-			}
+		execMsg, isExecMsg := m.(*authz.MsgExec)
+		if !isExecMsg {
+			flatMsgs = append(flatMsgs, m)
+			continue
 		}
 
+		innerMsgs, err := execMsg.GetMessages()
+		if err != nil {
+			return nil, false
+		}
+		innerFlatMsgs, ok := flattenMsgs(innerMsgs, depth+1)
+		if !ok {
+			return nil, false
+		}
+		flatMsgs = append(flatMsgs, innerFlatMsgs...)
+	}
+	return flatMsgs, true
+}
+
+// swapPaths returns the denoms on the path of every swap msg.
+// The first denom of a path is the swap in denom, and the last is the swap out denom.
+func swapPaths(msgs []sdk.Msg) [][]string {
+	paths := [][]string{}
+	for _, m := range msgs {
 		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
 		if !isSwapMsg {
 			continue
 		}
+		paths = append(paths, swapMsg.TokenDenomsOnPath())
+	}
+	return paths
+}
+
+// (1) Check that swap denom in != swap denom out
+func isSwapCycle(msgs []sdk.Msg) bool {
+	for _, path := range swapPaths(msgs) {
+		if path[0] == path[len(path)-1] {
+			return true
+		}
+	}
+	return false
+}
 
-		// (1) Check that swap denom in != swap denom out
-		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
+// (2) Check that all swaps have the same swap denom in
+func hasMultipleSwapInDenoms(msgs []sdk.Msg) bool {
+	swapInDenom := ""
+	for _, path := range swapPaths(msgs) {
+		if swapInDenom != "" && path[0] != swapInDenom {
 			return true
 		}
+		swapInDenom = path[0]
+	}
+	return false
+}
 
-		// (2)
-		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
+// (3) Check that no denom is seen twice across all swaps, other than the swap denom in
+func hasRepeatedSwapDenoms(msgs []sdk.Msg) bool {
+	swapInDenoms := map[string]bool{}
+	denomsSeen := map[string]bool{}
+	for _, path := range swapPaths(msgs) {
+		if denomsSeen[path[0]] {
 			return true
 		}
-		swapInDenom = swapMsg.TokenInDenom()
+		swapInDenoms[path[0]] = true
+
+		for _, denom := range path[1:] {
+			if denomsSeen[denom] || swapInDenoms[denom] {
+				return true
+			}
+			denomsSeen[denom] = true
+		}
 	}
+	return false
+}
 
+// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
+func hasJoinAndExitPool(msgs []sdk.Msg) bool {
+	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+	for _, m := range msgs {
+		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
+		if !isLpMsg {
+			continue
+		}
+		lpTypesSeen[lpMsg.LiquidityChangeType()] = true
+		if len(lpTypesSeen) > 1 {
+			return true
+		}
+	}
 	return false
 }
@@ -0,0 +1,59 @@
+package txfee_filters_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/osmosis-labs/osmosis/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/x/txfees/keeper/txfee_filters"
+)
+
+func swapMsg(tokenInDenom string, path ...string) *types.MsgSwapExactAmountIn {
+	routes := make([]types.SwapAmountInRoute, len(path))
+	for i, denom := range path {
+		routes[i] = types.SwapAmountInRoute{PoolId: uint64(i + 1), TokenOutDenom: denom}
+	}
+	return &types.MsgSwapExactAmountIn{
+		Routes:            routes,
+		TokenIn:           sdk.NewInt64Coin(tokenInDenom, 10),
+		TokenOutMinAmount: sdk.OneInt(),
+	}
+}
+
+func execMsg(msgs ...sdk.Msg) *authz.MsgExec {
+	msg := authz.NewMsgExec(sdk.AccAddress("grantee"), msgs)
+	return &msg
+}
+
+func TestIsArbMsgsLoose(t *testing.T) {
+	nestedExecMsg := execMsg(swapMsg("uosmo", "uion"))
+	for i := 0; i < 7; i++ {
+		nestedExecMsg = execMsg(nestedExecMsg)
+	}
+
+	tests := []struct {
+		name  string
+		msgs  []sdk.Msg
+		isArb bool
+	}{
+		{"single swap", []sdk.Msg{swapMsg("uosmo", "uion", "uatom")}, false},
+		{"swaps with the same token in", []sdk.Msg{swapMsg("uosmo", "uion"), swapMsg("uosmo", "uatom")}, false},
+		{"swap cycle", []sdk.Msg{swapMsg("uosmo", "uion", "uosmo")}, true},
+		{"swaps with different tokens in", []sdk.Msg{swapMsg("uosmo", "uion"), swapMsg("uion", "uatom")}, true},
+		{"cycle in the middle of a route", []sdk.Msg{swapMsg("uosmo", "uion", "uatom", "uion", "ufoo")}, true},
+		{"denom repeated across swaps", []sdk.Msg{swapMsg("uosmo", "uion", "uatom"), swapMsg("uosmo", "uatom")}, true},
+		{"join and exit pool", []sdk.Msg{&types.MsgJoinPool{}, &types.MsgExitPool{}}, true},
+		{"authz exec of a single swap", []sdk.Msg{execMsg(swapMsg("uosmo", "uion"))}, false},
+		{"authz exec of a swap cycle", []sdk.Msg{execMsg(swapMsg("uosmo", "uion", "uosmo"))}, true},
+		{"swaps split across authz exec", []sdk.Msg{swapMsg("uosmo", "uion"), execMsg(execMsg(swapMsg("uion", "uosmo")))}, true},
+		{"authz exec nested too deep", []sdk.Msg{nestedExecMsg}, true},
+	}
+
+	for _, tc := range tests {
+		require.Equal(t, tc.isArb, txfee_filters.IsArbMsgsLoose(tc.msgs), tc.name)
+	}
+}
@@ -166,7 +166,11 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock executes all ABCI EndBlock logic respective to the txfees module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// The block gas meter is always set by BeginBlock, but not on contexts made outside of a block.
+	if blockGasMeter := ctx.BlockGasMeter(); blockGasMeter != nil {
+		am.keeper.UpdateBaseFee(ctx, blockGasMeter.GasConsumedToLimit())
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -8,7 +8,8 @@ import (
 
 // x/txfees module errors
 var (
-	ErrNoBaseDenom     = sdkerrors.Register(ModuleName, 1, "no base denom was set")
-	ErrTooManyFeeCoins = sdkerrors.Register(ModuleName, 2, "too many fee coins. only accepts fees in one denom")
-	ErrInvalidFeeToken = sdkerrors.Register(ModuleName, 3, "invalid fee token")
+	ErrNoBaseDenom      = sdkerrors.Register(ModuleName, 1, "no base denom was set")
+	ErrTooManyFeeCoins  = sdkerrors.Register(ModuleName, 2, "too many fee coins")
+	ErrInvalidFeeToken  = sdkerrors.Register(ModuleName, 3, "invalid fee token")
+	ErrLowPoolLiquidity = sdkerrors.Register(ModuleName, 4, "fee token pool liquidity is too low")
 )
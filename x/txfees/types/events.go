@@ -0,0 +1,10 @@
+package types
+
+const (
+	TypeEvtFeeTokenDelisted = "fee_token_delisted"
+
+	AttributeValueCategory    = ModuleName
+	AttributeKeyDenom         = "denom"
+	AttributeKeyPoolId        = "pool_id"
+	AttributeKeyPoolLiquidity = "pool_liquidity"
+)
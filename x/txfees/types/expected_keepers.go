@@ -1,11 +1,37 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/x/gamm/types"
 )
 
 // SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
 // The x/gamm keeper is expected to satisfy this interface
 type SpotPriceCalculator interface {
 	CalculateSpotPrice(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string) (sdk.Dec, error)
+	// CalculateTimeWeightedSpotPrice returns the spot price averaged over the window ending at the current block time.
+	CalculateTimeWeightedSpotPrice(ctx sdk.Context, poolId uint64, tokenInDenom, tokenOutDenom string, window time.Duration) (sdk.Dec, error)
+}
+
+// GammKeeper defines the contract needed to check the liquidity of fee token pools,
+// and to swap fee tokens into the base denom
+// The x/gamm keeper is expected to satisfy this interface
+type GammKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (gammtypes.PoolI, error)
+	SwapExactAmountIn(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string, tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, spotPriceAfter sdk.Dec, err error)
+}
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+}
+
+// BankKeeper defines the contract needed for the bank keeper in the txfees module
+type BankKeeper interface {
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
 }
@@ -1,12 +1,18 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default txfee genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		Basedenom: sdk.DefaultBondDenom,
 		Feetokens: []FeeToken{},
+		Params:    DefaultParams(),
+		BaseFee:   DefaultMinBaseFee,
 	}
 }
 
@@ -26,5 +32,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.BaseFee.IsNil() || gs.BaseFee.IsNegative() {
+		return fmt.Errorf("base fee must be non-negative: %s", gs.BaseFee)
+	}
+
 	return nil
 }
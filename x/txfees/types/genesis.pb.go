@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -23,17 +24,106 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Params holds parameters for the txfees module
+type Params struct {
+	// base_fee_enabled turns on the base fee, which raises the minimum base denom
+	// gas price of the mempool fee check above the validator's own min gas
+	// prices while blocks are fuller than the target
+	BaseFeeEnabled bool `protobuf:"varint,1,opt,name=base_fee_enabled,json=baseFeeEnabled,proto3" json:"base_fee_enabled,omitempty" yaml:"base_fee_enabled"`
+	// min_base_fee is the lowest the base fee can go, in base denom per gas
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_fee" yaml:"min_base_fee"`
+	// max_base_fee is the highest the base fee can go, in base denom per gas
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_base_fee" yaml:"max_base_fee"`
+	// target_block_gas is the block gas usage at which the base fee stays the
+	// same
+	TargetBlockGas uint64 `protobuf:"varint,4,opt,name=target_block_gas,json=targetBlockGas,proto3" json:"target_block_gas,omitempty" yaml:"target_block_gas"`
+	// base_fee_change_rate is the most the base fee can change by in one block,
+	// as a fraction of the base fee, reached when a block uses twice the target
+	// gas or none at all
+	BaseFeeChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=base_fee_change_rate,json=baseFeeChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_change_rate" yaml:"base_fee_change_rate"`
+	// min_fee_token_pool_liquidity is the minimum amount of the base denom in
+	// the pool of a fee token. Fee tokens whose pool has less are not accepted
+	// by proposals, and are removed from the whitelist at the end of each epoch
+	MinFeeTokenPoolLiquidity github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=min_fee_token_pool_liquidity,json=minFeeTokenPoolLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_fee_token_pool_liquidity" yaml:"min_fee_token_pool_liquidity"`
+	// epoch_identifier is the identifier of the epoch at the end of which the
+	// non base denom fees collected are swapped into the base denom, and the
+	// illiquid fee tokens are removed from the whitelist
+	EpochIdentifier string `protobuf:"bytes,7,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
+	// max_fee_swap_slippage is the most the swap of the fees collected in a fee
+	// token at the end of an epoch can get below their value at the time
+	// weighted price, as a fraction of it. A swap that would get less is skipped
+	// and retried at the end of the next epoch
+	MaxFeeSwapSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=max_fee_swap_slippage,json=maxFeeSwapSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_fee_swap_slippage" yaml:"max_fee_swap_slippage"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4423c18e3d020b37, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetBaseFeeEnabled() bool {
+	if m != nil {
+		return m.BaseFeeEnabled
+	}
+	return false
+}
+
+func (m *Params) GetTargetBlockGas() uint64 {
+	if m != nil {
+		return m.TargetBlockGas
+	}
+	return 0
+}
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
 // GenesisState defines the txfees module's genesis state.
 type GenesisState struct {
 	Basedenom string     `protobuf:"bytes,1,opt,name=basedenom,proto3" json:"basedenom,omitempty"`
 	Feetokens []FeeToken `protobuf:"bytes,2,rep,name=feetokens,proto3" json:"feetokens"`
+	Params    Params     `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// base_fee is the current base fee, in base denom per gas
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4423c18e3d020b37, []int{0}
+	return fileDescriptor_4423c18e3d020b37, []int{1}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +166,15 @@ func (m *GenesisState) GetFeetokens() []FeeToken {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.txfees.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.txfees.v1beta1.GenesisState")
 }
 
@@ -85,22 +183,141 @@ func init() {
 }
 
 var fileDescriptor_4423c18e3d020b37 = []byte{
-	// 232 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c, 0x4a, 0x2d,
-	0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xaa, 0xd2, 0x83, 0xa8, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd1, 0x07, 0xb1, 0x20, 0xaa, 0xa5, 0x54, 0x71, 0x98, 0x99, 0x96, 0x9a, 0x5a, 0x92,
-	0x9f, 0x9d, 0x9a, 0x07, 0x51, 0xa6, 0x54, 0xc4, 0xc5, 0xe3, 0x0e, 0xb1, 0x25, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0x48, 0x86, 0x8b, 0x33, 0x29, 0xb1, 0x38, 0x35, 0x25, 0x35, 0x2f, 0x3f, 0x57, 0x82,
-	0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0x21, 0x20, 0xe4, 0xc2, 0xc5, 0x09, 0xd3, 0x5f, 0x2c, 0xc1,
-	0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0xa4, 0xa0, 0x87, 0xdd, 0x59, 0x7a, 0x6e, 0xa9, 0xa9, 0x21, 0x20,
-	0x85, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x21, 0x34, 0x3a, 0x79, 0x9c, 0x78, 0x24, 0xc7,
-	0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c,
-	0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x5e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72,
-	0x7e, 0xae, 0x3e, 0xd4, 0x58, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0x02, 0xe6, 0x9d,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x27, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x3d, 0x5c, 0xe7, 0x43, 0x41, 0x01, 0x00, 0x00,
+	// 609 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x18, 0x8c, 0xdb, 0x90, 0x36, 0xdb, 0xaa, 0x2d, 0xa6, 0x80, 0xd5, 0x56, 0x4e, 0x64, 0x7e, 0x94,
+	0x4b, 0x6d, 0xb5, 0xdc, 0x10, 0x17, 0x4c, 0x93, 0x52, 0x09, 0x50, 0xe5, 0xc0, 0x05, 0x21, 0x59,
+	0x6b, 0xe7, 0x8b, 0xb3, 0x8a, 0xed, 0x35, 0xd9, 0x2d, 0x75, 0x2e, 0x48, 0xbc, 0x00, 0xe2, 0xc2,
+	0x3b, 0xf5, 0xc0, 0xa1, 0x47, 0xc4, 0x21, 0x42, 0xc9, 0x1b, 0xe4, 0x09, 0x90, 0xd7, 0x76, 0x92,
+	0x06, 0x72, 0x88, 0x38, 0x25, 0x19, 0x8f, 0xe7, 0x9b, 0xcc, 0xb7, 0xb3, 0xe8, 0x21, 0x65, 0x01,
+	0x65, 0x84, 0x19, 0x3c, 0x6e, 0x03, 0x30, 0xe3, 0xd3, 0x91, 0x03, 0x1c, 0x1f, 0x19, 0x1e, 0x84,
+	0xc0, 0x08, 0xd3, 0xa3, 0x1e, 0xe5, 0x54, 0xbe, 0x97, 0xb1, 0xf4, 0x94, 0xa5, 0x67, 0xac, 0xbd,
+	0x5d, 0x8f, 0x7a, 0x54, 0x50, 0x8c, 0xe4, 0x5b, 0xca, 0xde, 0x7b, 0xb4, 0x40, 0xb3, 0x0d, 0xc0,
+	0x69, 0x17, 0xc2, 0x94, 0xa6, 0xfd, 0x28, 0xa1, 0xd2, 0x39, 0xee, 0xe1, 0x80, 0xc9, 0x75, 0xb4,
+	0xe3, 0x60, 0x06, 0x76, 0x1b, 0xc0, 0x86, 0x10, 0x3b, 0x3e, 0xb4, 0x14, 0xa9, 0x2a, 0xd5, 0xd6,
+	0xcd, 0xfd, 0xf1, 0xa0, 0x72, 0xbf, 0x8f, 0x03, 0xff, 0xa9, 0x36, 0xcf, 0xd0, 0xac, 0xad, 0x04,
+	0x6a, 0x00, 0xd4, 0x53, 0x40, 0xf6, 0xd0, 0x66, 0x40, 0x42, 0x3b, 0x27, 0x2a, 0x2b, 0x55, 0xa9,
+	0x56, 0x36, 0xeb, 0x57, 0x83, 0x4a, 0xe1, 0xd7, 0xa0, 0xf2, 0xd8, 0x23, 0xbc, 0x73, 0xe1, 0xe8,
+	0x2e, 0x0d, 0x0c, 0x57, 0x58, 0xcc, 0x3e, 0x0e, 0x59, 0xab, 0x6b, 0xf0, 0x7e, 0x04, 0x4c, 0x3f,
+	0x01, 0x77, 0x3c, 0xa8, 0xdc, 0x49, 0x07, 0xce, 0x6a, 0x69, 0x16, 0x0a, 0x48, 0x68, 0xa6, 0xf3,
+	0xc4, 0x20, 0x1c, 0x4f, 0x07, 0xad, 0xfe, 0xe7, 0x20, 0x1c, 0xdf, 0x18, 0x84, 0xe3, 0x7c, 0x50,
+	0x1d, 0xed, 0x70, 0xdc, 0xf3, 0x80, 0xdb, 0x8e, 0x4f, 0xdd, 0xae, 0xed, 0x61, 0xa6, 0x14, 0xab,
+	0x52, 0xad, 0x38, 0x1b, 0xcc, 0x3c, 0x43, 0xb3, 0xb6, 0x52, 0xc8, 0x4c, 0x90, 0x53, 0xcc, 0xe4,
+	0xcf, 0x68, 0x77, 0x92, 0x9e, 0xdb, 0xc1, 0xa1, 0x07, 0x76, 0x0f, 0x73, 0x50, 0x6e, 0x09, 0xdf,
+	0xaf, 0x97, 0xf6, 0xbd, 0x3f, 0xb7, 0x91, 0x19, 0x4d, 0xcd, 0xba, 0x9d, 0x6d, 0xe5, 0x85, 0x00,
+	0x2d, 0xcc, 0x41, 0xfe, 0x2e, 0xa1, 0x83, 0x24, 0xcd, 0x84, 0x2b, 0x8e, 0x80, 0x1d, 0x51, 0xea,
+	0xdb, 0x3e, 0xf9, 0x78, 0x41, 0x5a, 0x84, 0xf7, 0x95, 0x92, 0x30, 0xf2, 0x6e, 0x09, 0x23, 0x67,
+	0x21, 0x1f, 0x0f, 0x2a, 0x0f, 0xa6, 0x9b, 0x5a, 0xa4, 0xad, 0x59, 0x4a, 0x40, 0xc2, 0x06, 0xc0,
+	0xdb, 0xe4, 0xe1, 0x39, 0xa5, 0xfe, 0xab, 0xfc, 0x91, 0xdc, 0x40, 0x3b, 0x10, 0x51, 0xb7, 0x63,
+	0x93, 0x16, 0x84, 0x9c, 0xb4, 0x09, 0xf4, 0x94, 0x35, 0x61, 0x65, 0x26, 0xde, 0x79, 0x86, 0x66,
+	0x6d, 0x0b, 0xe8, 0x6c, 0x82, 0xc8, 0x5f, 0x24, 0x74, 0x37, 0x59, 0x62, 0xe2, 0x81, 0x5d, 0xe2,
+	0xc8, 0x66, 0x3e, 0x89, 0x22, 0xec, 0x81, 0xb2, 0x2e, 0xd4, 0xde, 0x2c, 0x9d, 0xf0, 0xc1, 0xf4,
+	0x64, 0xfc, 0x25, 0xaa, 0x59, 0x72, 0x80, 0xe3, 0x06, 0x40, 0xf3, 0x12, 0x47, 0xcd, 0x1c, 0xfc,
+	0xba, 0x82, 0x36, 0x4f, 0xd3, 0xd6, 0x36, 0x79, 0x12, 0xfa, 0x01, 0x2a, 0x27, 0x9b, 0x68, 0x41,
+	0x48, 0x03, 0xd1, 0xa6, 0xb2, 0x35, 0x05, 0xe4, 0x13, 0x54, 0xce, 0xfb, 0xc8, 0x94, 0x95, 0xea,
+	0x6a, 0x6d, 0xe3, 0xb8, 0xaa, 0xff, 0xbb, 0xe6, 0x7a, 0x1e, 0x9e, 0x59, 0x4c, 0xfe, 0x87, 0x35,
+	0x7d, 0x51, 0x7e, 0x86, 0x4a, 0x91, 0xa8, 0xb0, 0xa8, 0xc0, 0xc6, 0xb1, 0xba, 0x48, 0x22, 0x2d,
+	0x7a, 0x26, 0x90, 0xbd, 0x23, 0x7f, 0x40, 0xeb, 0x93, 0x0a, 0x15, 0x45, 0x50, 0xcf, 0x97, 0x0e,
+	0x6a, 0xfb, 0xe6, 0x51, 0xd4, 0xac, 0xb5, 0xec, 0xf8, 0x99, 0x2f, 0xaf, 0x86, 0xaa, 0x74, 0x3d,
+	0x54, 0xa5, 0xdf, 0x43, 0x55, 0xfa, 0x36, 0x52, 0x0b, 0xd7, 0x23, 0xb5, 0xf0, 0x73, 0xa4, 0x16,
+	0xde, 0xeb, 0x33, 0xea, 0x99, 0xdf, 0x43, 0x1f, 0x3b, 0x2c, 0xff, 0x61, 0xc4, 0xf9, 0xd5, 0x25,
+	0x26, 0x39, 0x25, 0x71, 0x61, 0x3d, 0xf9, 0x33, 0x00, 0x41, 0xe9, 0x9c, 0x13, 0x2d, 0x05, 0x00,
+	0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxFeeSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxFeeSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size := m.MinFeeTokenPoolLiquidity.Size()
+		i -= size
+		if _, err := m.MinFeeTokenPoolLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.BaseFeeChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.TargetBlockGas != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TargetBlockGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.BaseFeeEnabled {
+		i--
+		if m.BaseFeeEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -123,6 +340,26 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.Feetokens) > 0 {
 		for iNdEx := len(m.Feetokens) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -158,6 +395,35 @@ func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFeeEnabled {
+		n += 2
+	}
+	l = m.MinBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.TargetBlockGas != 0 {
+		n += 1 + sovGenesis(uint64(m.TargetBlockGas))
+	}
+	l = m.BaseFeeChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinFeeTokenPoolLiquidity.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.MaxFeeSwapSlippage.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func (m *GenesisState) Size() (n int) {
 	if m == nil {
 		return 0
@@ -174,6 +440,10 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -183,6 +453,297 @@ func sovGenesis(x uint64) (n int) {
 func sozGenesis(x uint64) (n int) {
 	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BaseFeeEnabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBlockGas", wireType)
+			}
+			m.TargetBlockGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetBlockGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeTokenPoolLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFeeTokenPoolLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFeeSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFeeSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -278,6 +839,73 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "txfees"
@@ -12,9 +14,20 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// MaxFeeCoins is the maximum number of distinct denoms a tx fee can be paid in,
+	// as each of them is converted into the base denom when checking the fee.
+	MaxFeeCoins = 8
+
+	// FeeSwapTwapWindow is the window of the time weighted price that bounds the swaps
+	// of the fees collected in fee tokens at the end of an epoch.
+	// It is fixed here rather than taken from the node's mempool options, as it has to be
+	// the same on every node, and it must be within the pool price history retention.
+	FeeSwapTwapWindow = 10 * time.Minute
 )
 
 var (
 	BaseDenomKey         = []byte("base_denom")
 	FeeTokensStorePrefix = []byte("fee_tokens")
+	BaseFeeKey           = []byte("base_fee")
 )
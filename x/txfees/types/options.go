@@ -2,9 +2,13 @@ package types
 
 import (
 	"fmt"
+	"time"
+
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cast"
+
+	"github.com/osmosis-labs/osmosis/x/txfees/keeper/txfee_filters"
 )
 
 // If Options are not set in a config somewhere,
@@ -15,12 +19,26 @@ var DefaultMinGasPriceForArbitrageTx = sdk.ZeroDec()
 var DefaultMinGasPriceForHighGasTx = sdk.ZeroDec()
 var DefaultMaxGasWantedPerTx = uint64(25 * 1000 * 1000)
 var DefaultHighGasTxThreshold = uint64(1 * 1000 * 1000)
+var DefaultFeeTwapWindow = 10 * time.Minute
+
+// MsgGasPolicy is the mempool fee policy for txs containing a msg of a given type.
+type MsgGasPolicy struct {
+	// MinGasPrice is the minimum gas price of txs containing the msg type.
+	MinGasPrice sdk.Dec
+	// MaxGasWanted is the max gas wanted by txs containing the msg type. Zero leaves the max gas wanted per tx.
+	MaxGasWanted uint64
+}
 
 type MempoolFeeOptions struct {
 	MaxGasWantedPerTx         uint64
 	MinGasPriceForArbitrageTx sdk.Dec
 	HighGasTxThreshold        uint64
 	MinGasPriceForHighGasTx   sdk.Dec
+	// FeeTwapWindow is the window of the time weighted price used to convert
+	// fees into the base denom. Zero uses the spot price instead.
+	FeeTwapWindow time.Duration
+	// MsgGasPolicies are the policies for txs containing msgs of the type url they're keyed by.
+	MsgGasPolicies map[string]MsgGasPolicy
 }
 
 func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
@@ -29,6 +47,8 @@ func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: DefaultMinGasPriceForArbitrageTx.Clone(),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   DefaultMinGasPriceForHighGasTx.Clone(),
+		FeeTwapWindow:             DefaultFeeTwapWindow,
+		MsgGasPolicies:            map[string]MsgGasPolicy{},
 	}
 }
 
@@ -38,7 +58,57 @@ func NewMempoolFeeOptions(opts servertypes.AppOptions) MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: parseMinGasPriceForArbitrageTx(opts),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   parseMinGasPriceForHighGasTx(opts),
+		FeeTwapWindow:             parseFeeTwapWindow(opts),
+		MsgGasPolicies:            parseMsgGasPolicies(opts),
+	}
+}
+
+// MinGasPriceForMsgs returns the highest min gas price of the msg gas policies of the msgs,
+// or zero if none of them has a policy. See msgGasPoliciesFor.
+func (opts MempoolFeeOptions) MinGasPriceForMsgs(msgs []sdk.Msg) sdk.Dec {
+	minGasPrice := sdk.ZeroDec()
+	for _, policy := range opts.msgGasPoliciesFor(msgs) {
+		minGasPrice = sdk.MaxDec(minGasPrice, policy.MinGasPrice)
+	}
+	return minGasPrice
+}
+
+// MaxGasWantedForMsgs returns the max gas wanted per tx,
+// lowered to the lowest max gas wanted of the msg gas policies of the msgs. See msgGasPoliciesFor.
+func (opts MempoolFeeOptions) MaxGasWantedForMsgs(msgs []sdk.Msg) uint64 {
+	maxGasWanted := opts.MaxGasWantedPerTx
+	for _, policy := range opts.msgGasPoliciesFor(msgs) {
+		if policy.MaxGasWanted != 0 && policy.MaxGasWanted < maxGasWanted {
+			maxGasWanted = policy.MaxGasWanted
+		}
 	}
+	return maxGasWanted
+}
+
+// msgGasPoliciesFor returns the msg gas policies of the msgs, including the msgs wrapped in authz exec msgs,
+// so that wrapping a msg doesn't bypass its policy, and the exec msgs themselves. If the wrapped msgs can't
+// be looked into, as they are nested too deep or can't be unpacked, all policies are returned, so that the
+// strictest of them applies.
+func (opts MempoolFeeOptions) msgGasPoliciesFor(msgs []sdk.Msg) []MsgGasPolicy {
+	policies := []MsgGasPolicy{}
+	flatMsgs, ok := txfee_filters.FlattenMsgs(msgs)
+	if !ok {
+		for _, policy := range opts.MsgGasPolicies {
+			policies = append(policies, policy)
+		}
+		return policies
+	}
+
+	// flattening replaces exec msgs by the msgs they wrap, and a tx with nested exec msgs
+	// has an exec msg at the top, so matching the top level msgs too covers the exec msgs
+	for _, msgs := range [][]sdk.Msg{msgs, flatMsgs} {
+		for _, msg := range msgs {
+			if policy, ok := opts.MsgGasPolicies[sdk.MsgTypeURL(msg)]; ok {
+				policies = append(policies, policy)
+			}
+		}
+	}
+	return policies
 }
 
 func parseMaxGasWantedPerTx(opts servertypes.AppOptions) uint64 {
@@ -53,6 +123,74 @@ func parseMaxGasWantedPerTx(opts servertypes.AppOptions) uint64 {
 	return value
 }
 
+func parseFeeTwapWindow(opts servertypes.AppOptions) time.Duration {
+	valueInterface := opts.Get("osmosis-mempool.fee-twap-window")
+	if valueInterface == nil {
+		return DefaultFeeTwapWindow
+	}
+	value, err := cast.ToDurationE(valueInterface)
+	if err != nil || value < 0 {
+		panic("invalidly configured osmosis-mempool.fee-twap-window")
+	}
+	return value
+}
+
+// parseMsgGasPolicies parses the osmosis-mempool.msg-gas-policy array of tables, e.g.
+//
+//	[[osmosis-mempool.msg-gas-policy]]
+//	msg-type-url = "/osmosis.gamm.v1beta1.MsgSwapExactAmountIn"
+//	min-gas-price = ".01"
+//	max-gas-wanted = "2000000"
+func parseMsgGasPolicies(opts servertypes.AppOptions) map[string]MsgGasPolicy {
+	const optName = "osmosis-mempool.msg-gas-policy"
+	policies := map[string]MsgGasPolicy{}
+
+	valueInterface := opts.Get(optName)
+	if valueInterface == nil {
+		return policies
+	}
+	entries, err := cast.ToSliceE(valueInterface)
+	if err != nil {
+		panic("invalidly configured " + optName)
+	}
+
+	for _, entryInterface := range entries {
+		entry, err := cast.ToStringMapE(entryInterface)
+		if err != nil {
+			panic("invalidly configured " + optName)
+		}
+
+		msgTypeURL, err := cast.ToStringE(entry["msg-type-url"])
+		if err != nil || msgTypeURL == "" {
+			panic(fmt.Errorf("invalidly configured %v, missing msg-type-url", optName))
+		}
+		if _, ok := policies[msgTypeURL]; ok {
+			panic(fmt.Errorf("invalidly configured %v, duplicate msg-type-url %v", optName, msgTypeURL))
+		}
+
+		policy := MsgGasPolicy{MinGasPrice: sdk.ZeroDec()}
+		if minGasPrice, ok := entry["min-gas-price"]; ok {
+			minGasPriceStr, err := cast.ToStringE(minGasPrice)
+			if err != nil {
+				panic(fmt.Errorf("invalidly configured %v.min-gas-price of %v", optName, msgTypeURL))
+			}
+			// pre-pend 0 to allow the config to start with a decimal, e.g. ".01"
+			policy.MinGasPrice, err = sdk.NewDecFromStr("0" + minGasPriceStr)
+			if err != nil {
+				panic(fmt.Errorf("invalidly configured %v.min-gas-price of %v, err= %v", optName, msgTypeURL, err))
+			}
+		}
+		if maxGasWanted, ok := entry["max-gas-wanted"]; ok {
+			policy.MaxGasWanted, err = cast.ToUint64E(maxGasWanted)
+			if err != nil {
+				panic(fmt.Errorf("invalidly configured %v.max-gas-wanted of %v", optName, msgTypeURL))
+			}
+		}
+		policies[msgTypeURL] = policy
+	}
+	return policies
+}
+
 func parseMinGasPriceForArbitrageTx(opts servertypes.AppOptions) sdk.Dec {
 	return parseDecFromConfig(opts, "arbitrage-min-gas-fee", DefaultMinGasPriceForArbitrageTx.Clone())
 }
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newAppOptions(t *testing.T, config string) servertypes.AppOptions {
+	v := viper.New()
+	v.SetConfigType("toml")
+	require.NoError(t, v.ReadConfig(strings.NewReader(config)))
+	return v
+}
+
+func TestParseMsgGasPolicies(t *testing.T) {
+	opts := newAppOptions(t, `
+[osmosis-mempool]
+max-gas-wanted-per-tx = "25000000"
+
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/osmosis.gamm.v1beta1.MsgSwapExactAmountIn"
+min-gas-price = ".01"
+max-gas-wanted = "2000000"
+
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+max-gas-wanted = 100000
+
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.authz.v1beta1.MsgExec"
+min-gas-price = "0.5"
+`)
+	require.Equal(t, map[string]MsgGasPolicy{
+		"/osmosis.gamm.v1beta1.MsgSwapExactAmountIn": {MinGasPrice: sdk.MustNewDecFromStr("0.01"), MaxGasWanted: 2000000},
+		"/cosmos.bank.v1beta1.MsgSend":               {MinGasPrice: sdk.ZeroDec(), MaxGasWanted: 100000},
+		"/cosmos.authz.v1beta1.MsgExec":              {MinGasPrice: sdk.MustNewDecFromStr("0.5")},
+	}, NewMempoolFeeOptions(opts).MsgGasPolicies)
+
+	// no policies configured
+	opts = newAppOptions(t, `
+[osmosis-mempool]
+max-gas-wanted-per-tx = "25000000"
+`)
+	require.Empty(t, NewMempoolFeeOptions(opts).MsgGasPolicies)
+
+	for name, config := range map[string]string{
+		"not an array of tables": `
+[osmosis-mempool]
+msg-gas-policy = "/cosmos.bank.v1beta1.MsgSend"
+`,
+		"missing msg-type-url": `
+[[osmosis-mempool.msg-gas-policy]]
+min-gas-price = ".01"
+`,
+		"empty msg-type-url": `
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = ""
+min-gas-price = ".01"
+`,
+		"duplicate msg-type-url": `
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+min-gas-price = ".01"
+
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+max-gas-wanted = "100000"
+`,
+		"invalid min-gas-price": `
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+min-gas-price = "one"
+`,
+		"negative min-gas-price": `
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+min-gas-price = "-1"
+`,
+		"invalid max-gas-wanted": `
+[[osmosis-mempool.msg-gas-policy]]
+msg-type-url = "/cosmos.bank.v1beta1.MsgSend"
+max-gas-wanted = "lots"
+`,
+	} {
+		opts := newAppOptions(t, config)
+		require.Panics(t, func() { parseMsgGasPolicies(opts) }, name)
+	}
+}
@@ -0,0 +1,205 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/x/epochs/types"
+)
+
+// Parameter store keys
+var (
+	KeyBaseFeeEnabled    = []byte("BaseFeeEnabled")
+	KeyMinBaseFee        = []byte("MinBaseFee")
+	KeyMaxBaseFee        = []byte("MaxBaseFee")
+	KeyTargetBlockGas    = []byte("TargetBlockGas")
+	KeyBaseFeeChangeRate = []byte("BaseFeeChangeRate")
+
+	KeyMinFeeTokenPoolLiquidity = []byte("MinFeeTokenPoolLiquidity")
+	KeyEpochIdentifier          = []byte("EpochIdentifier")
+	KeyMaxFeeSwapSlippage       = []byte("MaxFeeSwapSlippage")
+)
+
+var (
+	DefaultMinBaseFee        = sdk.MustNewDecFromStr("0.0025")
+	DefaultMaxBaseFee        = sdk.NewDec(10)
+	DefaultTargetBlockGas    = uint64(30 * 1000 * 1000)
+	DefaultBaseFeeChangeRate = sdk.NewDecWithPrec(125, 3)
+
+	DefaultMinFeeTokenPoolLiquidity = sdk.ZeroInt()
+	DefaultEpochIdentifier          = "day"
+	DefaultMaxFeeSwapSlippage       = sdk.NewDecWithPrec(5, 2)
+)
+
+// ParamTable for txfees module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(baseFeeEnabled bool, minBaseFee, maxBaseFee sdk.Dec, targetBlockGas uint64, baseFeeChangeRate sdk.Dec,
+	minFeeTokenPoolLiquidity sdk.Int, epochIdentifier string, maxFeeSwapSlippage sdk.Dec,
+) Params {
+	return Params{
+		BaseFeeEnabled:           baseFeeEnabled,
+		MinBaseFee:               minBaseFee,
+		MaxBaseFee:               maxBaseFee,
+		TargetBlockGas:           targetBlockGas,
+		BaseFeeChangeRate:        baseFeeChangeRate,
+		MinFeeTokenPoolLiquidity: minFeeTokenPoolLiquidity,
+		EpochIdentifier:          epochIdentifier,
+		MaxFeeSwapSlippage:       maxFeeSwapSlippage,
+	}
+}
+
+// default txfees module parameters.
+// The base fee and the fee token pool liquidity minimum are disabled by default,
+// and left to governance to turn on.
+func DefaultParams() Params {
+	return Params{
+		BaseFeeEnabled:           false,
+		MinBaseFee:               DefaultMinBaseFee,
+		MaxBaseFee:               DefaultMaxBaseFee,
+		TargetBlockGas:           DefaultTargetBlockGas,
+		BaseFeeChangeRate:        DefaultBaseFeeChangeRate,
+		MinFeeTokenPoolLiquidity: DefaultMinFeeTokenPoolLiquidity,
+		EpochIdentifier:          DefaultEpochIdentifier,
+		MaxFeeSwapSlippage:       DefaultMaxFeeSwapSlippage,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateBaseFeeEnabled(p.BaseFeeEnabled); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBound(p.MinBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeBound(p.MaxBaseFee); err != nil {
+		return err
+	}
+
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+
+	if err := validateBaseFeeChangeRate(p.BaseFeeChangeRate); err != nil {
+		return err
+	}
+
+	if err := validateMinFeeTokenPoolLiquidity(p.MinFeeTokenPoolLiquidity); err != nil {
+		return err
+	}
+
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.EpochIdentifier); err != nil {
+		return err
+	}
+
+	if err := validateMaxFeeSwapSlippage(p.MaxFeeSwapSlippage); err != nil {
+		return err
+	}
+
+	if p.MinBaseFee.GT(p.MaxBaseFee) {
+		return fmt.Errorf("min base fee (%s) must not exceed max base fee (%s)", p.MinBaseFee, p.MaxBaseFee)
+	}
+
+	return nil
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyBaseFeeEnabled, &p.BaseFeeEnabled, validateBaseFeeEnabled),
+		paramtypes.NewParamSetPair(KeyMinBaseFee, &p.MinBaseFee, validateBaseFeeBound),
+		paramtypes.NewParamSetPair(KeyMaxBaseFee, &p.MaxBaseFee, validateBaseFeeBound),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseFeeChangeRate, &p.BaseFeeChangeRate, validateBaseFeeChangeRate),
+		paramtypes.NewParamSetPair(KeyMinFeeTokenPoolLiquidity, &p.MinFeeTokenPoolLiquidity, validateMinFeeTokenPoolLiquidity),
+		paramtypes.NewParamSetPair(KeyEpochIdentifier, &p.EpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
+		paramtypes.NewParamSetPair(KeyMaxFeeSwapSlippage, &p.MaxFeeSwapSlippage, validateMaxFeeSwapSlippage),
+	}
+}
+
+// ClampBaseFee returns the base fee bounded by the min and max base fee.
+func (p Params) ClampBaseFee(baseFee sdk.Dec) sdk.Dec {
+	return sdk.MinDec(sdk.MaxDec(baseFee, p.MinBaseFee), p.MaxBaseFee)
+}
+
+func validateBaseFeeEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBaseFeeBound(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// a zero base fee could never grow again, as it changes by a fraction of itself
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("base fee bound must be positive: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("target block gas must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("base fee change rate must be between 0 and 1 exclusive: %s", v)
+	}
+
+	return nil
+}
+
+func validateMinFeeTokenPoolLiquidity(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("min fee token pool liquidity must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxFeeSwapSlippage(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max fee swap slippage must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = &QueryEstimateFeeRequest{}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (req QueryEstimateFeeRequest) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, msgAny := range req.Msgs {
+		var msg sdk.Msg
+		err := unpacker.UnpackAny(msgAny, &msg)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSDKMsgs returns the unpacked msgs of the request.
+func (req QueryEstimateFeeRequest) GetSDKMsgs() ([]sdk.Msg, error) {
+	msgs := make([]sdk.Msg, len(req.Msgs))
+	for i, msgAny := range req.Msgs {
+		msg, ok := msgAny.GetCachedValue().(sdk.Msg)
+		if !ok {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "msgs contains %T which is not a sdk.Msg", msgAny)
+		}
+		msgs[i] = msg
+	}
+	return msgs, nil
+}
@@ -6,6 +6,9 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -278,6 +281,302 @@ func (m *QueryBaseDenomResponse) GetBaseDenom() string {
 	return ""
 }
 
+type QueryBaseFeeRequest struct {
+}
+
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{6}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+type QueryBaseFeeResponse struct {
+	BaseFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee" yaml:"base_fee"`
+	Enabled bool                                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{7}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{8}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{9}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+type QueryEstimateFeeRequest struct {
+	Gas uint64 `protobuf:"varint,1,opt,name=gas,proto3" json:"gas,omitempty" yaml:"gas"`
+	// fee_denom is the base denom or a whitelisted fee token
+	FeeDenom string `protobuf:"bytes,2,opt,name=fee_denom,json=feeDenom,proto3" json:"fee_denom,omitempty" yaml:"fee_denom"`
+	// msgs are the messages of the tx, optionally given to check whether the tx
+	// is classified as an arbitrage tx
+	Msgs []*types.Any `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs,omitempty"`
+}
+
+func (m *QueryEstimateFeeRequest) Reset()         { *m = QueryEstimateFeeRequest{} }
+func (m *QueryEstimateFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeRequest) ProtoMessage()    {}
+func (*QueryEstimateFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{10}
+}
+func (m *QueryEstimateFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeRequest.Merge(m, src)
+}
+func (m *QueryEstimateFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *QueryEstimateFeeRequest) GetFeeDenom() string {
+	if m != nil {
+		return m.FeeDenom
+	}
+	return ""
+}
+
+func (m *QueryEstimateFeeRequest) GetMsgs() []*types.Any {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+type QueryEstimateFeeResponse struct {
+	// fee is the minimum fee in the fee denom
+	Fee types1.Coin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee" yaml:"fee"`
+	// base_fee is the minimum fee in the base denom, which fee converts to
+	BaseFee types1.Coin `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3" json:"base_fee" yaml:"base_fee"`
+	// min_base_gas_price is the minimum gas price in the base denom
+	MinBaseGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_base_gas_price,json=minBaseGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_base_gas_price" yaml:"min_base_gas_price"`
+	IsHighGasTx     bool                                   `protobuf:"varint,4,opt,name=is_high_gas_tx,json=isHighGasTx,proto3" json:"is_high_gas_tx,omitempty" yaml:"is_high_gas_tx"`
+	IsArbitrageTx   bool                                   `protobuf:"varint,5,opt,name=is_arbitrage_tx,json=isArbitrageTx,proto3" json:"is_arbitrage_tx,omitempty" yaml:"is_arbitrage_tx"`
+}
+
+func (m *QueryEstimateFeeResponse) Reset()         { *m = QueryEstimateFeeResponse{} }
+func (m *QueryEstimateFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEstimateFeeResponse) ProtoMessage()    {}
+func (*QueryEstimateFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cbc1b48c44dfdd6, []int{11}
+}
+func (m *QueryEstimateFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEstimateFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEstimateFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEstimateFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEstimateFeeResponse.Merge(m, src)
+}
+func (m *QueryEstimateFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEstimateFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEstimateFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEstimateFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEstimateFeeResponse) GetFee() types1.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryEstimateFeeResponse) GetBaseFee() types1.Coin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types1.Coin{}
+}
+
+func (m *QueryEstimateFeeResponse) GetIsHighGasTx() bool {
+	if m != nil {
+		return m.IsHighGasTx
+	}
+	return false
+}
+
+func (m *QueryEstimateFeeResponse) GetIsArbitrageTx() bool {
+	if m != nil {
+		return m.IsArbitrageTx
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*QueryFeeTokensRequest)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensRequest")
 	proto.RegisterType((*QueryFeeTokensResponse)(nil), "osmosis.txfees.v1beta1.QueryFeeTokensResponse")
@@ -285,6 +584,12 @@ func init() {
 	proto.RegisterType((*QueryDenomPoolIdResponse)(nil), "osmosis.txfees.v1beta1.QueryDenomPoolIdResponse")
 	proto.RegisterType((*QueryBaseDenomRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomRequest")
 	proto.RegisterType((*QueryBaseDenomResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseDenomResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.txfees.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.txfees.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryEstimateFeeRequest)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeRequest")
+	proto.RegisterType((*QueryEstimateFeeResponse)(nil), "osmosis.txfees.v1beta1.QueryEstimateFeeResponse")
 }
 
 func init() {
@@ -292,38 +597,69 @@ func init() {
 }
 
 var fileDescriptor_6cbc1b48c44dfdd6 = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x18, 0xcd, 0x5a, 0x5b, 0xc8, 0x54, 0x44, 0x07, 0xdb, 0xc6, 0x20, 0x9b, 0x30, 0xa8, 0x94, 0x42,
-	0x66, 0x6c, 0xd5, 0x8b, 0x37, 0x97, 0x52, 0xf4, 0x22, 0xba, 0x78, 0xea, 0x25, 0xcc, 0x9a, 0x6f,
-	0xd7, 0xc5, 0x64, 0xbf, 0x6d, 0x66, 0x56, 0x1a, 0xc4, 0x8b, 0xbf, 0x40, 0x10, 0xfc, 0x0b, 0xfe,
-	0x95, 0x1e, 0x0b, 0x5e, 0x3c, 0x05, 0x49, 0xfc, 0x05, 0xf9, 0x05, 0xb2, 0xb3, 0xb3, 0x49, 0x68,
-	0x9a, 0xa6, 0xb7, 0xcc, 0xf7, 0xde, 0xbc, 0xef, 0xcd, 0x7b, 0x59, 0xc2, 0x50, 0xf5, 0x50, 0xc5,
-	0x4a, 0xe8, 0xd3, 0x10, 0x40, 0x89, 0xcf, 0xfb, 0x01, 0x68, 0xb9, 0x2f, 0x4e, 0x32, 0xe8, 0x0f,
-	0x78, 0xda, 0x47, 0x8d, 0x74, 0xdb, 0x72, 0x78, 0xc1, 0xe1, 0x96, 0x53, 0xbf, 0x17, 0x61, 0x84,
-	0x86, 0x22, 0xf2, 0x5f, 0x05, 0xbb, 0xfe, 0x20, 0x42, 0x8c, 0xba, 0x20, 0x64, 0x1a, 0x0b, 0x99,
-	0x24, 0xa8, 0xa5, 0x8e, 0x31, 0x51, 0x16, 0x75, 0x2d, 0x6a, 0x4e, 0x41, 0x16, 0x8a, 0x4e, 0xd6,
-	0x37, 0x04, 0x8b, 0x3f, 0x5a, 0xe2, 0x27, 0x04, 0xd0, 0xf8, 0x09, 0x2c, 0x8d, 0xed, 0x90, 0xad,
-	0x77, 0xb9, 0xc3, 0x23, 0x80, 0xf7, 0xf9, 0x58, 0xf9, 0x70, 0x92, 0x81, 0xd2, 0x4c, 0x93, 0xed,
-	0x8b, 0x80, 0x4a, 0x31, 0x51, 0x40, 0x8f, 0x09, 0x09, 0x01, 0xda, 0x46, 0x45, 0xd5, 0x9c, 0xe6,
-	0xda, 0xee, 0xe6, 0x41, 0x93, 0x5f, 0xfe, 0x34, 0x5e, 0x5e, 0xf7, 0xee, 0x9f, 0x0d, 0x1b, 0x95,
-	0xc9, 0xb0, 0x71, 0x77, 0x20, 0x7b, 0xdd, 0x17, 0x6c, 0xa6, 0xc0, 0xfc, 0x6a, 0x58, 0xee, 0x60,
-	0x2f, 0xc9, 0x8e, 0xd9, 0x7a, 0x08, 0x09, 0xf6, 0xde, 0x22, 0x76, 0x5f, 0x77, 0xac, 0x21, 0xfa,
-	0x98, 0xac, 0x77, 0xf2, 0x69, 0xcd, 0x69, 0x3a, 0xbb, 0x55, 0xef, 0xce, 0x64, 0xd8, 0xb8, 0x55,
-	0x68, 0x99, 0x31, 0xf3, 0x0b, 0x98, 0x1d, 0x91, 0xda, 0xa2, 0x84, 0xb5, 0xbe, 0x47, 0x36, 0xd2,
-	0x7c, 0x72, 0x68, 0x44, 0x6e, 0x7a, 0x74, 0x32, 0x6c, 0xdc, 0x2e, 0x44, 0xf2, 0x79, 0x3b, 0xee,
-	0x30, 0xdf, 0x32, 0xa6, 0xc9, 0x78, 0x52, 0x81, 0xd1, 0x2a, 0x93, 0x79, 0x63, 0x93, 0x99, 0x03,
-	0xac, 0xfc, 0x33, 0x42, 0x02, 0xa9, 0xa0, 0x3d, 0xef, 0x73, 0x6b, 0xf6, 0xe6, 0x19, 0xc6, 0xfc,
-	0x6a, 0x50, 0xde, 0x3e, 0x18, 0xaf, 0x91, 0x75, 0x23, 0x48, 0x7f, 0x3a, 0xa4, 0x3a, 0xcd, 0x9b,
-	0xb6, 0x96, 0x65, 0x7a, 0x69, 0x61, 0x75, 0x7e, 0x5d, 0x7a, 0x61, 0x96, 0xed, 0x7d, 0xfb, 0xfd,
-	0xef, 0xc7, 0x8d, 0x87, 0x94, 0x89, 0xe5, 0xff, 0x14, 0x5b, 0x11, 0xfd, 0xe5, 0x90, 0xcd, 0xb9,
-	0x3c, 0xa9, 0xb8, 0x72, 0xd7, 0x62, 0x79, 0xf5, 0x27, 0xd7, 0xbf, 0x60, 0xed, 0x3d, 0x37, 0xf6,
-	0x04, 0x6d, 0x2d, 0xb3, 0x67, 0x82, 0x6c, 0xdb, 0xda, 0xc4, 0x17, 0x73, 0xfc, 0x6a, 0x22, 0x9c,
-	0x16, 0xb3, 0x22, 0xc2, 0x8b, 0xcd, 0xae, 0x88, 0x70, 0xa1, 0xef, 0xd5, 0x11, 0xce, 0x1a, 0xf7,
-	0x5e, 0x9d, 0x8d, 0x5c, 0xe7, 0x7c, 0xe4, 0x3a, 0x7f, 0x47, 0xae, 0xf3, 0x7d, 0xec, 0x56, 0xce,
-	0xc7, 0x6e, 0xe5, 0xcf, 0xd8, 0xad, 0x1c, 0xf3, 0x28, 0xd6, 0x1f, 0xb3, 0x80, 0x7f, 0xc0, 0x5e,
-	0xa9, 0xd3, 0xea, 0xca, 0x40, 0x4d, 0x45, 0x4f, 0x4b, 0x59, 0x3d, 0x48, 0x41, 0x05, 0x1b, 0xe6,
-	0xcb, 0x7d, 0xfa, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x10, 0xa1, 0xf9, 0xc5, 0x72, 0x04, 0x00, 0x00,
+	// 992 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0x23, 0x45,
+	0x10, 0xcd, 0xc4, 0xf9, 0x2c, 0x43, 0xb2, 0xf4, 0xe6, 0xc3, 0x1e, 0x21, 0x8f, 0xd5, 0x5a, 0x22,
+	0x2b, 0xbb, 0x99, 0xd9, 0x84, 0x0f, 0x21, 0x84, 0x16, 0xc5, 0x84, 0xec, 0x22, 0x04, 0x0a, 0x43,
+	0x4e, 0x2b, 0x24, 0xab, 0xc7, 0x6e, 0x4f, 0x5a, 0x6b, 0x4f, 0x7b, 0xdd, 0x63, 0xe4, 0x28, 0xca,
+	0x85, 0x1b, 0x17, 0x84, 0x84, 0x84, 0x38, 0x73, 0x81, 0x23, 0x12, 0x7f, 0x62, 0x8f, 0x2b, 0x71,
+	0x41, 0x1c, 0x46, 0x28, 0xe1, 0x17, 0xf8, 0x17, 0xa0, 0xee, 0xe9, 0x19, 0x4f, 0xe2, 0x9d, 0xc4,
+	0x9c, 0x12, 0x57, 0xbd, 0x7a, 0xf5, 0xba, 0xaa, 0xe6, 0x01, 0xe6, 0xa2, 0xcb, 0x05, 0x13, 0x4e,
+	0x38, 0x6c, 0x53, 0x2a, 0x9c, 0x6f, 0x76, 0x3d, 0x1a, 0x92, 0x5d, 0xe7, 0xf9, 0x80, 0xf6, 0x4f,
+	0xed, 0x5e, 0x9f, 0x87, 0x1c, 0x6d, 0x68, 0x8c, 0x1d, 0x63, 0x6c, 0x8d, 0x31, 0xd7, 0x7c, 0xee,
+	0x73, 0x05, 0x71, 0xe4, 0x7f, 0x31, 0xda, 0x7c, 0xd3, 0xe7, 0xdc, 0xef, 0x50, 0x87, 0xf4, 0x98,
+	0x43, 0x82, 0x80, 0x87, 0x24, 0x64, 0x3c, 0x10, 0x3a, 0x5b, 0xd6, 0x59, 0xf5, 0xcb, 0x1b, 0xb4,
+	0x1d, 0x12, 0xe8, 0x36, 0x66, 0xe5, 0x7a, 0xaa, 0x35, 0xe8, 0xab, 0xda, 0x24, 0xdf, 0x54, 0x3a,
+	0x1c, 0x8f, 0x08, 0x9a, 0xea, 0x6c, 0x72, 0x96, 0xe4, 0xdf, 0xca, 0x79, 0x4a, 0x9b, 0xd2, 0x90,
+	0x3f, 0xa3, 0x09, 0xec, 0x5e, 0x0e, 0xcc, 0xa7, 0x01, 0x95, 0x8f, 0x54, 0x28, 0xbc, 0x09, 0xeb,
+	0x5f, 0xca, 0x11, 0x1c, 0x52, 0x7a, 0x2c, 0x8b, 0x85, 0x4b, 0x9f, 0x0f, 0xa8, 0x08, 0x71, 0x08,
+	0x1b, 0xd7, 0x13, 0xa2, 0xc7, 0x03, 0x41, 0xd1, 0x53, 0x80, 0x36, 0xa5, 0x0d, 0xd5, 0x4b, 0x94,
+	0x8c, 0x6a, 0xa1, 0x56, 0xdc, 0xab, 0xda, 0xaf, 0x9e, 0x9d, 0x9d, 0x94, 0xd7, 0xcb, 0x2f, 0x22,
+	0x6b, 0x66, 0x14, 0x59, 0x6f, 0x9c, 0x92, 0x6e, 0xe7, 0x03, 0x3c, 0x66, 0xc0, 0xee, 0x72, 0x3b,
+	0xe9, 0x81, 0xf7, 0x61, 0x53, 0x75, 0x3d, 0xa0, 0x01, 0xef, 0x1e, 0x71, 0xde, 0xf9, 0xb4, 0xa5,
+	0x05, 0xa1, 0x2d, 0x98, 0x6f, 0xc9, 0x68, 0xc9, 0xa8, 0x1a, 0xb5, 0xe5, 0xfa, 0x9d, 0x51, 0x64,
+	0xbd, 0x16, 0x73, 0xa9, 0x30, 0x76, 0xe3, 0x34, 0x3e, 0x84, 0xd2, 0x24, 0x85, 0x96, 0xbe, 0x0d,
+	0x0b, 0x3d, 0x19, 0x39, 0x50, 0x24, 0x73, 0x75, 0x34, 0x8a, 0xac, 0x95, 0x98, 0x44, 0xc6, 0x1b,
+	0xac, 0x85, 0x5d, 0x8d, 0x48, 0x27, 0x53, 0x27, 0x82, 0x2a, 0xae, 0x64, 0x32, 0x5f, 0xc0, 0xc6,
+	0xf5, 0x84, 0xa6, 0x7f, 0x07, 0x40, 0x2e, 0xad, 0x91, 0xd5, 0xb9, 0x3e, 0x7e, 0xf3, 0x38, 0x87,
+	0xdd, 0x65, 0x2f, 0xa9, 0xc6, 0xeb, 0x70, 0x37, 0xe5, 0x3b, 0xa4, 0x34, 0x69, 0xf3, 0x8b, 0x01,
+	0x6b, 0x57, 0xe3, 0xba, 0xcb, 0xd7, 0xb0, 0xa4, 0x98, 0xda, 0x94, 0xea, 0x1e, 0xfb, 0x72, 0xb6,
+	0x7f, 0x47, 0xd6, 0x96, 0xcf, 0xc2, 0x93, 0x81, 0x67, 0x37, 0x79, 0xd7, 0xd1, 0x47, 0x14, 0xff,
+	0xd9, 0x11, 0xad, 0x67, 0x4e, 0x78, 0xda, 0xa3, 0xc2, 0x3e, 0xa0, 0xcd, 0x51, 0x64, 0xad, 0x66,
+	0x14, 0xb5, 0x29, 0xc5, 0xee, 0xa2, 0x17, 0x77, 0x41, 0x0f, 0x60, 0x91, 0x06, 0xc4, 0xeb, 0xd0,
+	0x56, 0x69, 0xb6, 0x6a, 0xd4, 0x96, 0xb2, 0x33, 0xd2, 0x09, 0xec, 0x26, 0x10, 0xbc, 0x06, 0x48,
+	0x69, 0x3c, 0x22, 0x7d, 0xd2, 0x4d, 0x6f, 0xe7, 0x2b, 0xb8, 0x7b, 0x25, 0xaa, 0x85, 0x7f, 0x08,
+	0x0b, 0x3d, 0x15, 0x51, 0xb2, 0x8b, 0x7b, 0x95, 0xbc, 0xa3, 0x89, 0xeb, 0xea, 0x73, 0xf2, 0x59,
+	0xae, 0xae, 0xc1, 0x3f, 0x1b, 0xfa, 0x36, 0x3e, 0x11, 0x21, 0xeb, 0x92, 0x30, 0x33, 0x2b, 0x54,
+	0x85, 0x82, 0x4f, 0x84, 0x5e, 0xea, 0xca, 0x28, 0xb2, 0x20, 0x16, 0xec, 0x13, 0x81, 0x5d, 0x99,
+	0x42, 0xbb, 0x20, 0xaf, 0x4c, 0x6f, 0x66, 0x56, 0x4d, 0x6d, 0x6d, 0x14, 0x59, 0x77, 0xc6, 0xd7,
+	0xa8, 0x17, 0xb3, 0xd4, 0xa6, 0xf1, 0x5e, 0x50, 0x0d, 0xe6, 0xba, 0xc2, 0x17, 0xa5, 0x82, 0xba,
+	0xf0, 0x35, 0x3b, 0xfe, 0x6c, 0xed, 0xe4, 0xb3, 0xb5, 0xf7, 0x83, 0x53, 0x57, 0x21, 0xf0, 0x6f,
+	0x05, 0x28, 0x4d, 0x4a, 0xd3, 0xaf, 0xfe, 0x08, 0x0a, 0xc9, 0xa6, 0x8a, 0x7b, 0x65, 0x3b, 0x5e,
+	0x88, 0x2d, 0xc7, 0x9d, 0xbe, 0xf7, 0x63, 0xce, 0x82, 0x3a, 0xd2, 0x1f, 0x08, 0xa4, 0x92, 0xb0,
+	0x2b, 0x2b, 0xd1, 0xe7, 0x99, 0x7d, 0xcf, 0xde, 0xc6, 0xb2, 0xa9, 0x59, 0xf2, 0x17, 0x3c, 0x04,
+	0xd4, 0x65, 0x41, 0x43, 0x65, 0x7c, 0x22, 0x1a, 0xbd, 0x3e, 0x6b, 0xd2, 0x52, 0x41, 0x8d, 0xe4,
+	0xb3, 0xff, 0x7d, 0x48, 0xe5, 0xb8, 0xcf, 0x24, 0x23, 0x76, 0x57, 0xbb, 0x2c, 0x90, 0xb7, 0xfb,
+	0x98, 0x88, 0x23, 0x19, 0x41, 0x8f, 0x60, 0x85, 0x89, 0xc6, 0x09, 0xf3, 0x4f, 0x14, 0x2c, 0x1c,
+	0x96, 0xe6, 0xd4, 0x85, 0x95, 0x47, 0x91, 0xb5, 0x1e, 0xf3, 0x5c, 0xcd, 0x63, 0xb7, 0xc8, 0xc4,
+	0x13, 0xe6, 0x9f, 0x3c, 0x26, 0xe2, 0x78, 0x88, 0xea, 0xb0, 0xca, 0x44, 0x83, 0xf4, 0x3d, 0x16,
+	0xf6, 0x89, 0x4f, 0x25, 0xc1, 0xbc, 0x22, 0x30, 0x47, 0x91, 0xb5, 0x91, 0x12, 0x64, 0x01, 0xd8,
+	0x7d, 0x9d, 0x89, 0xfd, 0x24, 0x70, 0x3c, 0xdc, 0xfb, 0x63, 0x11, 0xe6, 0xd5, 0xaa, 0xd0, 0x4f,
+	0x06, 0x2c, 0xa7, 0xe6, 0x86, 0x76, 0xf2, 0x6e, 0xf1, 0x95, 0xee, 0x68, 0xda, 0xd3, 0xc2, 0xe3,
+	0x23, 0xc0, 0xdb, 0xdf, 0xfe, 0xf9, 0xef, 0x8f, 0xb3, 0xf7, 0x10, 0x76, 0xf2, 0xcd, 0x5b, 0xfb,
+	0x21, 0xfa, 0xd5, 0x80, 0x62, 0xc6, 0xbc, 0x90, 0x73, 0x63, 0xaf, 0x49, 0xa7, 0x34, 0x1f, 0x4e,
+	0x5f, 0xa0, 0xe5, 0xbd, 0xab, 0xe4, 0x39, 0x68, 0x27, 0x4f, 0x9e, 0xfa, 0x38, 0x1a, 0xda, 0x23,
+	0x9d, 0x33, 0xf5, 0xf3, 0x5c, 0x8d, 0x30, 0x75, 0xc1, 0x5b, 0x46, 0x78, 0xdd, 0x46, 0x4d, 0x7b,
+	0x5a, 0xf8, 0xb4, 0x23, 0x1c, 0xdb, 0x2b, 0xfa, 0xde, 0x80, 0x45, 0x6d, 0x9b, 0xe8, 0xfe, 0xad,
+	0x7d, 0xc6, 0x46, 0x62, 0x3e, 0x98, 0x0e, 0xac, 0x25, 0xd5, 0x94, 0x24, 0x8c, 0xaa, 0x37, 0x4a,
+	0x92, 0xdf, 0xf0, 0x77, 0x06, 0x2c, 0xc4, 0xae, 0x86, 0xb6, 0x6f, 0x6c, 0x71, 0xc5, 0x48, 0xcd,
+	0xfb, 0x53, 0x61, 0xb5, 0x9a, 0x2d, 0xa5, 0xa6, 0x8a, 0x2a, 0x79, 0x6a, 0x62, 0x23, 0x45, 0xbf,
+	0x1b, 0x50, 0xcc, 0x18, 0xd5, 0x2d, 0xf7, 0x35, 0xe9, 0xb6, 0xe6, 0xc3, 0xe9, 0x0b, 0xb4, 0xb4,
+	0x47, 0x4a, 0xda, 0xfb, 0xe8, 0xbd, 0x3c, 0x69, 0x54, 0x17, 0xc9, 0x61, 0x39, 0x67, 0x3e, 0x11,
+	0xe7, 0xce, 0x59, 0x6a, 0xca, 0xe7, 0xf5, 0x27, 0x2f, 0x2e, 0x2a, 0xc6, 0xcb, 0x8b, 0x8a, 0xf1,
+	0xcf, 0x45, 0xc5, 0xf8, 0xe1, 0xb2, 0x32, 0xf3, 0xf2, 0xb2, 0x32, 0xf3, 0xd7, 0x65, 0x65, 0xe6,
+	0xa9, 0x9d, 0x71, 0x2a, 0xcd, 0xbd, 0xd3, 0x21, 0x9e, 0x48, 0x1b, 0x0d, 0x93, 0x56, 0xca, 0xb5,
+	0xbc, 0x05, 0x65, 0xdf, 0x6f, 0xff, 0x37, 0x00, 0x1b, 0xe8, 0xa1, 0x62, 0x10, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +680,13 @@ type QueryClient interface {
 	FeeTokens(ctx context.Context, in *QueryFeeTokensRequest, opts ...grpc.CallOption) (*QueryFeeTokensResponse, error)
 	DenomPoolId(ctx context.Context, in *QueryDenomPoolIdRequest, opts ...grpc.CallOption) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(ctx context.Context, in *QueryBaseDenomRequest, opts ...grpc.CallOption) (*QueryBaseDenomResponse, error)
+	// BaseFee returns the current base fee, the minimum gas price in the base
+	// denom for a tx to enter the mempool while the base fee is enabled
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateFee returns the minimum fee in the fee denom for a tx with the
+	// given gas to enter the mempool of the queried node
+	EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error)
 }
 
 type queryClient struct {
@@ -381,6 +724,33 @@ func (c *queryClient) BaseDenom(ctx context.Context, in *QueryBaseDenomRequest,
 	return out, nil
 }
 
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EstimateFee(ctx context.Context, in *QueryEstimateFeeRequest, opts ...grpc.CallOption) (*QueryEstimateFeeResponse, error) {
+	out := new(QueryEstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.txfees.v1beta1.Query/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// FeeTokens returns a list of all the whitelisted fee tokens and their
@@ -389,6 +759,13 @@ type QueryServer interface {
 	FeeTokens(context.Context, *QueryFeeTokensRequest) (*QueryFeeTokensResponse, error)
 	DenomPoolId(context.Context, *QueryDenomPoolIdRequest) (*QueryDenomPoolIdResponse, error)
 	BaseDenom(context.Context, *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error)
+	// BaseFee returns the current base fee, the minimum gas price in the base
+	// denom for a tx to enter the mempool while the base fee is enabled
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateFee returns the minimum fee in the fee denom for a tx with the
+	// given gas to enter the mempool of the queried node
+	EstimateFee(context.Context, *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -404,6 +781,15 @@ func (*UnimplementedQueryServer) DenomPoolId(ctx context.Context, req *QueryDeno
 func (*UnimplementedQueryServer) BaseDenom(ctx context.Context, req *QueryBaseDenomRequest) (*QueryBaseDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseDenom not implemented")
 }
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateFee(ctx context.Context, req *QueryEstimateFeeRequest) (*QueryEstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -463,6 +849,60 @@ func _Query_BaseDenom_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.txfees.v1beta1.Query/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateFee(ctx, req.(*QueryEstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -479,6 +919,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseDenom",
 			Handler:    _Query_BaseDenom_Handler,
 		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Query_EstimateFee_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/txfees/v1beta1/query.proto",
@@ -655,24 +1107,268 @@ func (m *QueryBaseDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryFeeTokensRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeeDenom) > 0 {
+		i -= len(m.FeeDenom)
+		copy(dAtA[i:], m.FeeDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeeDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IsArbitrageTx {
+		i--
+		if m.IsArbitrageTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsHighGasTx {
+		i--
+		if m.IsHighGasTx {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinBaseGasPrice.Size()
+		i -= size
+		if _, err := m.MinBaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryFeeTokensRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *QueryFeeTokensResponse) Size() (n int) {
@@ -737,6 +1433,92 @@ func (m *QueryBaseDenomResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEstimateFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	l = len(m.FeeDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinBaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.IsHighGasTx {
+		n += 2
+	}
+	if m.IsArbitrageTx {
+		n += 2
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1160,6 +1942,618 @@ func (m *QueryBaseDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, &types.Any{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsHighGasTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsHighGasTx = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsArbitrageTx", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsArbitrageTx = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0